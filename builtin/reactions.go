@@ -0,0 +1,82 @@
+// Package builtin holds the stats.StatProcessor implementations that ship
+// compiled into the statsd binary, as opposed to the ones loaded at startup
+// from Config.PluginsDir via the Go plugin package.
+package builtin
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// Reactions is the built-in stats.StatProcessor that scores every configured
+// reaction (see stats.ReactionScorer) into a per-category counter, e.g.
+// "likes"/"dislikes" by default.
+type Reactions struct {
+	scorer  *stats.ReactionScorer
+	history stats.ReactionHistoryService
+}
+
+// NewReactions returns a new instance of Reactions driven by scorer, with
+// history used to replay the exact delta a ReactionRemoved event should
+// negate even after scorer's weights have changed.
+func NewReactions(scorer *stats.ReactionScorer, history stats.ReactionHistoryService) *Reactions {
+	return &Reactions{scorer: scorer, history: history}
+}
+
+// Name returns the unique identifier of this processor.
+func (r *Reactions) Name() string {
+	return "builtin.reactions"
+}
+
+// Keys returns the counter categories currently configured on scorer.
+func (r *Reactions) Keys() []string {
+	return r.scorer.Categories()
+}
+
+// OnReactionAdded scores the reaction and, if tracked, increments the
+// matching category counter and records the applied delta for later replay.
+func (r *Reactions) OnReactionAdded(ev *slackevents.ReactionAddedEvent, tx stats.StatTx) error {
+	category, weight, ok := r.scorer.Score(ev.Reaction)
+	if !ok {
+		return nil
+	}
+
+	if err := tx.IncrementCounter(ev.ItemUser, stats.NewMonthYear(time.Now().UTC()), category, weight); err != nil {
+		return err
+	}
+
+	return r.history.RecordDelta(stats.ReactionDelta{
+		ItemTS:   ev.Item.Timestamp,
+		User:     ev.User,
+		ItemUser: ev.ItemUser,
+		Reaction: ev.Reaction,
+		Category: category,
+		Weight:   weight,
+	})
+}
+
+// OnReactionRemoved negates the exact delta recorded when the reaction was
+// added. If no record exists (e.g. it predates this feature), it falls back
+// to scoring the reaction against the current weights.
+func (r *Reactions) OnReactionRemoved(ev *slackevents.ReactionRemovedEvent, tx stats.StatTx) error {
+	delta, err := r.history.FindDelta(ev.Item.Timestamp, ev.User, ev.Reaction)
+	if errors.Is(err, stats.ErrNotFound) {
+		category, weight, ok := r.scorer.Score(ev.Reaction)
+		if !ok {
+			return nil
+		}
+		return tx.IncrementCounter(ev.ItemUser, stats.NewMonthYear(time.Now().UTC()), category, -weight)
+	} else if err != nil {
+		return err
+	}
+
+	return tx.IncrementCounter(ev.ItemUser, stats.NewMonthYear(time.Now().UTC()), delta.Category, -delta.Weight)
+}
+
+// OnMessage is a no-op; Reactions does not derive counters from messages.
+func (r *Reactions) OnMessage(ev *slackevents.MessageEvent, tx stats.StatTx) error {
+	return nil
+}
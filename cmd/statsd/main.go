@@ -12,7 +12,11 @@ import (
 	"os/user"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/ddritzenhoff/stats"
+	"github.com/ddritzenhoff/stats/builtin"
+	"github.com/ddritzenhoff/stats/external"
 	"github.com/ddritzenhoff/stats/http"
 	"github.com/ddritzenhoff/stats/sqlite"
 	_ "github.com/mattn/go-sqlite3"
@@ -53,6 +57,13 @@ type Main struct {
 	// HTTP server for handling HTTP communication.
 	// SQLite services are attached to it before running.
 	HTTPServer *http.Server
+
+	// socketMode is set when Config.Slack.Transport is "socket"; Close shuts
+	// down its read loop.
+	socketMode *http.SlackSocketMode
+
+	// Scheduler fires the recurring recap jobs named by Config.Schedule; Close stops it.
+	Scheduler *stats.Scheduler
 }
 
 // NewMain returns a new instance of Main.
@@ -70,8 +81,9 @@ func (m *Main) Run(ctx context.Context) error {
 	var configPath string
 	flag.StringVar(&configPath, "config", "", "config file (extension: .json)")
 	flag.Parse()
+	m.ConfigPath = configPath
 
-	cfg, err := ReadConfigFile(configPath)
+	cfg, err := ReadConfigFile(m.ConfigPath)
 	if err != nil {
 		return err
 	}
@@ -88,12 +100,101 @@ func (m *Main) Run(ctx context.Context) error {
 
 	memberService := sqlite.NewMemberService(m.DB)
 	leaderboardService := sqlite.NewLeaderboardService(m.DB)
+	statService := sqlite.NewStatService(m.DB)
+	reactionHistoryService := sqlite.NewReactionHistoryService(m.DB)
+	scheduleRunService := sqlite.NewScheduleRunService(m.DB)
+	externalLeaderboardService := sqlite.NewExternalLeaderboardService(m.DB)
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
-	slackService, err := http.NewSlackService(logger, memberService, leaderboardService, cfg.Slack.SigningSecret, cfg.Slack.BotSigningKey, cfg.Slack.ChannelID)
-	if err != nil {
-		return fmt.Errorf("Run NewSlackService: %w", err)
+
+	externalSources := make(map[string]stats.ExternalSource, len(cfg.ExternalSources))
+	for _, src := range cfg.ExternalSources {
+		externalSources[src.Name] = external.NewAdventOfCode(src.Name, src.URL, src.SessionCookie)
+	}
+
+	if cfg.Reactions == nil {
+		cfg.Reactions = DefaultConfig().Reactions
+	}
+	scorer := stats.NewReactionScorer(cfg.Reactions)
+
+	registry := stats.NewStatRegistry()
+	if err := registry.Register(builtin.NewReactions(scorer, reactionHistoryService)); err != nil {
+		return fmt.Errorf("Run: %w", err)
+	}
+	if err := loadPlugins(cfg.PluginsDir, registry); err != nil {
+		return fmt.Errorf("Run loadPlugins: %w", err)
 	}
 
+	reloadReactions := func() (map[string]stats.ReactionWeight, error) {
+		newCfg, err := ReadConfigFile(m.ConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("reloadReactions: %w", err)
+		}
+		if newCfg.Reactions == nil {
+			return DefaultConfig().Reactions, nil
+		}
+		return newCfg.Reactions, nil
+	}
+
+	var slackService http.Slacker
+	scheduler := stats.NewScheduler(logger, scheduleRunService)
+	switch cfg.Slack.Transport {
+	case "socket":
+		socketService, err := http.NewSlackSocketModeService(logger, memberService, leaderboardService, leaderboardService, externalLeaderboardService, externalSources, statService, registry, scheduler, scorer, reloadReactions, cfg.Slack.AppToken, cfg.Slack.BotSigningKey, cfg.Slack.ChannelID)
+		if err != nil {
+			return fmt.Errorf("Run NewSlackSocketModeService: %w", err)
+		}
+		go func() {
+			if err := socketService.Run(ctx); err != nil {
+				logger.Error("socket mode stopped", slog.String("err", err.Error()))
+			}
+		}()
+		m.socketMode = socketService
+		slackService = socketService
+	default:
+		eventsService, err := http.NewSlackService(logger, memberService, leaderboardService, leaderboardService, externalLeaderboardService, externalSources, statService, registry, scheduler, scorer, reloadReactions, cfg.Slack.SigningSecret, cfg.Slack.BotSigningKey, cfg.Slack.ChannelID)
+		if err != nil {
+			return fmt.Errorf("Run NewSlackService: %w", err)
+		}
+		slackService = eventsService
+	}
+
+	scheduler.RegisterAction("monthly_update", func(entry stats.ScheduleEntry) error {
+		return slackService.PostMonthlyUpdate(stats.NewMonthYear(time.Now().UTC()), entry.Channel)
+	})
+	scheduler.RegisterAction("weekly_top", func(entry stats.ScheduleEntry) error {
+		return slackService.PostWeeklyUpdate(time.Now().UTC(), entry.Channel)
+	})
+	for _, src := range cfg.ExternalSources {
+		sourceName := src.Name
+		action := "external_sync_" + sourceName
+		scheduler.RegisterAction(action, func(entry stats.ScheduleEntry) error {
+			date := stats.NewMonthYear(time.Now().UTC())
+			if err := slackService.SyncExternalLeaderboard(sourceName, date); err != nil {
+				return err
+			}
+			return slackService.PostExternalLeaderboardUpdate(sourceName, date, entry.Channel)
+		})
+		pollMinutes := src.PollMinutes
+		if pollMinutes <= 0 {
+			pollMinutes = 60
+		}
+		if err := scheduler.Schedule(stats.ScheduleEntry{
+			Name:    sourceName + "_poll",
+			Cron:    fmt.Sprintf("@every %dm", pollMinutes),
+			Action:  action,
+			Channel: src.Channel,
+		}); err != nil {
+			return fmt.Errorf("Run Schedule %s: %w", sourceName, err)
+		}
+	}
+	for _, entry := range cfg.Schedule {
+		if err := scheduler.Schedule(entry); err != nil {
+			return fmt.Errorf("Run Schedule %s: %w", entry.Name, err)
+		}
+	}
+	scheduler.Start()
+	m.Scheduler = scheduler
+
 	m.HTTPServer = http.NewServer(logger, cfg.HTTP.Addr, slackService)
 	if err := m.HTTPServer.Open(); err != nil {
 		return fmt.Errorf("Run: %w", err)
@@ -103,6 +204,14 @@ func (m *Main) Run(ctx context.Context) error {
 }
 
 func (m *Main) Close() error {
+	if m.Scheduler != nil {
+		<-m.Scheduler.Stop().Done()
+	}
+	if m.socketMode != nil {
+		if err := m.socketMode.Close(); err != nil {
+			return err
+		}
+	}
 	if m.HTTPServer != nil {
 		if err := m.HTTPServer.Close(); err != nil {
 			return err
@@ -168,13 +277,53 @@ type Config struct {
 		SigningSecret string `json:"signing_secret"`
 		BotSigningKey string `json:"bot_signing_key"`
 		ChannelID     string `json:"channel_id"`
+
+		// AppToken is the app-level token (xapp-...) used to open a Socket
+		// Mode connection. Only read when Transport is "socket".
+		AppToken string `json:"app_token"`
+
+		// Transport selects how Slack events reach the process: "events"
+		// (default) for the public HTTP Events API, or "socket" for Socket
+		// Mode, which needs no reachable public URL.
+		Transport string `json:"transport"`
 	} `json:"slack"`
+
+	// PluginsDir names a directory of `.so` files built with
+	// `go build -buildmode=plugin`, each exporting a `func New() stats.StatProcessor`.
+	// Left empty, no plugins are loaded.
+	PluginsDir string `json:"plugins_dir"`
+
+	// Reactions maps a Slack emoji name (without colons) to the category and
+	// weight it scores, e.g. {"tada": {"category":"likes","weight":2}}.
+	Reactions map[string]stats.ReactionWeight `json:"reactions"`
+
+	// Schedule lists the recurring recap jobs the built-in scheduler fires,
+	// e.g. {"name":"monthly_recap","cron":"0 9 1 * *","action":"monthly_update","channel":"C123"}.
+	Schedule []stats.ScheduleEntry `json:"schedule"`
+
+	// ExternalSources lists the third-party leaderboards (currently Advent of
+	// Code) to poll every PollMinutes and post recaps for, e.g.
+	// {"name":"aoc2024","url":"https://adventofcode.com/2024/leaderboard/private/view/123.json","session_cookie":"...","poll_minutes":30,"channel":"C123"}.
+	ExternalSources []ExternalSourceConfig `json:"external_sources"`
+}
+
+// ExternalSourceConfig configures one polled ExternalSource.
+type ExternalSourceConfig struct {
+	Name          string `json:"name"`
+	URL           string `json:"url"`
+	SessionCookie string `json:"session_cookie"`
+	PollMinutes   int    `json:"poll_minutes"`
+	Channel       string `json:"channel"`
 }
 
 // DefaultConfig returns a new instance of Config with defaults set.
 func DefaultConfig() Config {
 	var config Config
 	config.DB.DSN = DefaultDSN
+	config.Reactions = map[string]stats.ReactionWeight{
+		"+1": {Category: "likes", Weight: 1},
+		"-1": {Category: "dislikes", Weight: 1},
+	}
 	return config
 }
 
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"plugin"
+
+	"github.com/ddritzenhoff/stats"
+)
+
+// loadPlugins opens every `.so` file in dir, resolves its `New` symbol, and
+// registers the resulting stats.StatProcessor with reg. dir == "" is a no-op,
+// so plugin loading stays opt-in.
+func loadPlugins(dir string, reg *stats.StatRegistry) error {
+	if dir == "" {
+		return nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("loadPlugins ReadDir: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".so" {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		p, err := plugin.Open(path)
+		if err != nil {
+			return fmt.Errorf("loadPlugins Open %s: %w", path, err)
+		}
+
+		sym, err := p.Lookup("New")
+		if err != nil {
+			return fmt.Errorf("loadPlugins Lookup %s: %w", path, err)
+		}
+		newFunc, ok := sym.(func() stats.StatProcessor)
+		if !ok {
+			return fmt.Errorf("loadPlugins: %s does not export `func New() stats.StatProcessor`", path)
+		}
+
+		if err := reg.Register(newFunc()); err != nil {
+			return fmt.Errorf("loadPlugins %s: %w", path, err)
+		}
+	}
+	return nil
+}
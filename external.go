@@ -0,0 +1,59 @@
+package stats
+
+import "time"
+
+// ExternalMember is a normalized row from an ExternalSource's leaderboard,
+// independent of the source's own JSON shape (Advent of Code, GitHub, etc.).
+type ExternalMember struct {
+	ID             string
+	DisplayName    string
+	Score          int64
+	LastProgressAt time.Time
+}
+
+// ExternalSource fetches a leaderboard from a third-party service.
+type ExternalSource interface {
+	// Name identifies the source, e.g. "adventofcode2024". It is used as the
+	// key for ExternalLeaderboardService snapshots and member_external_links.
+	Name() string
+
+	// FetchLeaderboard retrieves the current leaderboard from the source.
+	FetchLeaderboard() ([]ExternalMember, error)
+}
+
+// ExternalLeaderboardSnapshot is the leaderboard fetched from source at the
+// time of saving, scoped to date so month-over-month deltas can be computed.
+type ExternalLeaderboardSnapshot struct {
+	Source  string
+	Date    MonthYear
+	Members []ExternalMember
+}
+
+// ExternalLeaderboardService persists per-source ExternalLeaderboardSnapshots
+// and the links between Slack users and external members.
+type ExternalLeaderboardService interface {
+	// SaveSnapshot stores snapshot, keyed by (snapshot.Source, snapshot.Date).
+	// Saving the same key twice overwrites the prior snapshot.
+	SaveSnapshot(snapshot ExternalLeaderboardSnapshot) error
+
+	// FindSnapshot retrieves the snapshot saved for (source, date).
+	// Returns ErrNotFound if no matching snapshot exists.
+	FindSnapshot(source string, date MonthYear) (*ExternalLeaderboardSnapshot, error)
+
+	// LinkMember attaches slackUID to externalID within source, so recaps can
+	// @-mention the Slack user instead of falling back to their display name.
+	LinkMember(source string, externalID string, slackUID string) error
+
+	// FindSlackUID returns the Slack UID linked to externalID within source.
+	// Returns ErrNotFound if externalID has no link.
+	FindSlackUID(source string, externalID string) (string, error)
+}
+
+// PreviousMonthYear returns the MonthYear immediately before my.
+func PreviousMonthYear(my MonthYear) (MonthYear, error) {
+	t, err := time.Parse(MonthYearLayout, my.String())
+	if err != nil {
+		return "", err
+	}
+	return NewMonthYear(t.AddDate(0, -1, 0)), nil
+}
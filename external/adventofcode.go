@@ -0,0 +1,97 @@
+// Package external provides stats.ExternalSource adapters for third-party
+// leaderboards (Advent of Code, GitHub contribution counts, etc.) that the
+// scheduler polls and AdminHandlers posts recaps for.
+package external
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ddritzenhoff/stats"
+)
+
+// AdventOfCode fetches a private leaderboard from adventofcode.com.
+type AdventOfCode struct {
+	// URL is the leaderboard JSON endpoint, e.g.
+	// https://adventofcode.com/2024/leaderboard/private/view/123456.json.
+	URL string
+
+	// SessionCookie is the value of AoC's "session" auth cookie.
+	SessionCookie string
+
+	name       string
+	httpClient *http.Client
+}
+
+// NewAdventOfCode returns a new instance of AdventOfCode identified by name
+// (used as the ExternalLeaderboardService source key).
+func NewAdventOfCode(name string, url string, sessionCookie string) *AdventOfCode {
+	return &AdventOfCode{
+		URL:           url,
+		SessionCookie: sessionCookie,
+		name:          name,
+		httpClient:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name returns the identifier this source was constructed with.
+func (a *AdventOfCode) Name() string {
+	return a.name
+}
+
+// aocLeaderboard mirrors the subset of AoC's private leaderboard JSON shape
+// this adapter cares about.
+type aocLeaderboard struct {
+	Members map[string]aocMember `json:"members"`
+}
+
+type aocMember struct {
+	Name       string `json:"name"`
+	LocalScore int64  `json:"local_score"`
+	LastStarTS int64  `json:"last_star_ts"`
+}
+
+// FetchLeaderboard retrieves and normalizes the current AoC leaderboard.
+func (a *AdventOfCode) FetchLeaderboard() ([]stats.ExternalMember, error) {
+	req, err := http.NewRequest(http.MethodGet, a.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("FetchLeaderboard: %w", err)
+	}
+	req.Header.Set("Cookie", "session="+a.SessionCookie)
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("FetchLeaderboard: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("FetchLeaderboard: unexpected status %s", resp.Status)
+	}
+
+	var board aocLeaderboard
+	if err := json.NewDecoder(resp.Body).Decode(&board); err != nil {
+		return nil, fmt.Errorf("FetchLeaderboard Decode: %w", err)
+	}
+
+	members := make([]stats.ExternalMember, 0, len(board.Members))
+	for id, m := range board.Members {
+		displayName := m.Name
+		if displayName == "" {
+			displayName = fmt.Sprintf("anonymous user #%s", id)
+		}
+		var lastProgressAt time.Time
+		if m.LastStarTS > 0 {
+			lastProgressAt = time.Unix(m.LastStarTS, 0).UTC()
+		}
+		members = append(members, stats.ExternalMember{
+			ID:             id,
+			DisplayName:    displayName,
+			Score:          m.LocalScore,
+			LastProgressAt: lastProgressAt,
+		})
+	}
+	return members, nil
+}
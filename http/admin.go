@@ -0,0 +1,262 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/slack-go/slack"
+)
+
+// AdminHandlers implements the admin/reporting endpoints shared by every
+// Slacker transport: posting recaps, reloading reaction weights, and
+// inspecting/triggering the Scheduler. Event ingestion (HTTP Events API vs.
+// Socket Mode) differs per transport, but these never do.
+type AdminHandlers struct {
+	LeaderboardService         stats.LeaderboardService
+	WeeklyLeaderboardService   stats.WeeklyLeaderboardService
+	ExternalLeaderboardService stats.ExternalLeaderboardService
+	Scorer                     *stats.ReactionScorer
+	Scheduler                  *stats.Scheduler
+
+	// ExternalSources indexes the configured stats.ExternalSource values by
+	// their Name(), e.g. "adventofcode2024", for PostExternalLeaderboardUpdate
+	// and the scheduler's poll actions to look up.
+	ExternalSources map[string]stats.ExternalSource
+
+	// ReloadReactions re-reads the reaction weight configuration from its
+	// source (e.g. the config file) for HandleReactionsReload to swap into
+	// Scorer. It is injected so this package stays decoupled from the
+	// concrete Config type.
+	ReloadReactions func() (map[string]stats.ReactionWeight, error)
+
+	client    *slack.Client
+	logger    *slog.Logger
+	ChannelID string
+}
+
+// NewAdminHandlers returns a new instance of AdminHandlers.
+func NewAdminHandlers(logger *slog.Logger, client *slack.Client, ls stats.LeaderboardService, wls stats.WeeklyLeaderboardService, els stats.ExternalLeaderboardService, sources map[string]stats.ExternalSource, scheduler *stats.Scheduler, scorer *stats.ReactionScorer, reloadReactions func() (map[string]stats.ReactionWeight, error), channelID string) *AdminHandlers {
+	return &AdminHandlers{
+		LeaderboardService:         ls,
+		WeeklyLeaderboardService:   wls,
+		ExternalLeaderboardService: els,
+		ExternalSources:            sources,
+		Scheduler:                  scheduler,
+		Scorer:                     scorer,
+		ReloadReactions:            reloadReactions,
+		client:                     client,
+		logger:                     logger,
+		ChannelID:                  channelID,
+	}
+}
+
+// HandleReactionsReload re-reads the reaction weight configuration and swaps
+// it into the live ReactionScorer, so weight/category changes take effect
+// without restarting the process.
+func (a *AdminHandlers) HandleReactionsReload(w http.ResponseWriter, r *http.Request) error {
+	weights, err := a.ReloadReactions()
+	if err != nil {
+		return fmt.Errorf("HandleReactionsReload: %w", err)
+	}
+	a.Scorer.Reload(weights)
+	a.logger.Info("reloaded reaction weights", slog.Int("count", len(weights)))
+	return nil
+}
+
+// PostMonthlyUpdate builds and posts the monthly recap for date to channelID.
+func (a *AdminHandlers) PostMonthlyUpdate(date stats.MonthYear, channelID string) error {
+	t, err := time.Parse(stats.MonthYearLayout, date.String())
+	if err != nil {
+		return fmt.Errorf("PostMonthlyUpdate: %w", err)
+	}
+
+	leaderboard, err := a.LeaderboardService.FindLeaderboard(t)
+	if err != nil {
+		return err
+	}
+
+	var sectionBlocks []slack.Block
+	headerText := slack.NewTextBlockObject("mrkdwn", "*Monthly Stats Update*", false, false)
+	headerSection := slack.NewHeaderBlock(headerText)
+	sectionBlocks = append(sectionBlocks, headerSection)
+
+	mostLikesReceivedMembers := fmt.Sprintf("Most likes received this month (aka good boy of the month): <@%s> (%d)", leaderboard.MostReceivedLikesMember.SlackUID, leaderboard.MostReceivedLikesMember.ReceivedLikes)
+	sectionText := slack.NewTextBlockObject("mrkdwn", mostLikesReceivedMembers, false, false)
+	sectionBlocks = append(sectionBlocks, slack.NewSectionBlock(sectionText, nil, nil))
+
+	mostDislikesReceivedMembers := fmt.Sprintf("Most dislikes received this month: <@%s> (%d)", leaderboard.MostReceivedDislikesMember.SlackUID, leaderboard.MostReceivedDislikesMember.ReceivedDislikes)
+	sectionText = slack.NewTextBlockObject("mrkdwn", mostDislikesReceivedMembers, false, false)
+	sectionBlocks = append(sectionBlocks, slack.NewSectionBlock(sectionText, nil, nil))
+
+	msg := slack.MsgOptionBlocks(sectionBlocks...)
+	if _, _, err := a.client.PostMessage(channelID, msg); err != nil {
+		return fmt.Errorf("PostMonthlyUpdate PostMessage: %w", err)
+	}
+	return nil
+}
+
+// HandleMonthlyUpdate is a thin HTTP wrapper around PostMonthlyUpdate, kept
+// for callers still POSTing a `date` form value directly (e.g. an external cron).
+func (a *AdminHandlers) HandleMonthlyUpdate(w http.ResponseWriter, r *http.Request) error {
+	err := r.ParseForm()
+	if err != nil {
+		return err
+	}
+
+	rawDate := r.FormValue("date")
+	if rawDate == "" {
+		return errors.New("no date value provided within the form")
+	}
+	date, err := stats.NewMonthYearString(rawDate)
+	if err != nil {
+		return err
+	}
+
+	return a.PostMonthlyUpdate(date, a.ChannelID)
+}
+
+// PostWeeklyUpdate builds and posts the weekly top recap for the 7 days
+// ending on weekEnding to channelID.
+func (a *AdminHandlers) PostWeeklyUpdate(weekEnding time.Time, channelID string) error {
+	leaderboard, err := a.WeeklyLeaderboardService.FindWeeklyLeaderboard(weekEnding)
+	if err != nil {
+		return err
+	}
+
+	var sectionBlocks []slack.Block
+	headerText := slack.NewTextBlockObject("mrkdwn", "*Weekly Top*", false, false)
+	sectionBlocks = append(sectionBlocks, slack.NewHeaderBlock(headerText))
+
+	mostLikesReceivedMembers := fmt.Sprintf("Most likes received this week: <@%s> (%d)", leaderboard.MostReceivedLikesMember.SlackUID, leaderboard.MostReceivedLikesMember.ReceivedLikes)
+	sectionText := slack.NewTextBlockObject("mrkdwn", mostLikesReceivedMembers, false, false)
+	sectionBlocks = append(sectionBlocks, slack.NewSectionBlock(sectionText, nil, nil))
+
+	msg := slack.MsgOptionBlocks(sectionBlocks...)
+	if _, _, err := a.client.PostMessage(channelID, msg); err != nil {
+		return fmt.Errorf("PostWeeklyUpdate PostMessage: %w", err)
+	}
+	return nil
+}
+
+// SyncExternalLeaderboard fetches source's current leaderboard and saves it
+// as the snapshot for date, so PostExternalLeaderboardUpdate (and next
+// month's diff) have something to read.
+func (a *AdminHandlers) SyncExternalLeaderboard(source string, date stats.MonthYear) error {
+	src, ok := a.ExternalSources[source]
+	if !ok {
+		return fmt.Errorf("SyncExternalLeaderboard: unknown source %q: %w", source, stats.ErrNotFound)
+	}
+
+	members, err := src.FetchLeaderboard()
+	if err != nil {
+		return fmt.Errorf("SyncExternalLeaderboard FetchLeaderboard: %w", err)
+	}
+
+	return a.ExternalLeaderboardService.SaveSnapshot(stats.ExternalLeaderboardSnapshot{
+		Source:  source,
+		Date:    date,
+		Members: members,
+	})
+}
+
+// PostExternalLeaderboardUpdate builds and posts a recap of source's
+// snapshot for date, showing current ranks and the score gained since the
+// previous month's snapshot, to channelID. Members linked to a Slack UID via
+// HandleExternalLink are @-mentioned; unlinked members fall back to their
+// external DisplayName.
+func (a *AdminHandlers) PostExternalLeaderboardUpdate(source string, date stats.MonthYear, channelID string) error {
+	snapshot, err := a.ExternalLeaderboardService.FindSnapshot(source, date)
+	if err != nil {
+		return fmt.Errorf("PostExternalLeaderboardUpdate FindSnapshot: %w", err)
+	}
+
+	previousScores := make(map[string]int64)
+	if previousDate, err := stats.PreviousMonthYear(date); err == nil {
+		if previous, err := a.ExternalLeaderboardService.FindSnapshot(source, previousDate); err == nil {
+			for _, m := range previous.Members {
+				previousScores[m.ID] = m.Score
+			}
+		} else if !errors.Is(err, stats.ErrNotFound) {
+			return fmt.Errorf("PostExternalLeaderboardUpdate FindSnapshot previous: %w", err)
+		}
+	}
+
+	members := append([]stats.ExternalMember(nil), snapshot.Members...)
+	sort.Slice(members, func(i, j int) bool { return members[i].Score > members[j].Score })
+
+	var sectionBlocks []slack.Block
+	headerText := slack.NewTextBlockObject("mrkdwn", fmt.Sprintf("*%s Leaderboard*", source), false, false)
+	sectionBlocks = append(sectionBlocks, slack.NewHeaderBlock(headerText))
+
+	for rank, m := range members {
+		who := m.DisplayName
+		if slackUID, err := a.ExternalLeaderboardService.FindSlackUID(source, m.ID); err == nil {
+			who = fmt.Sprintf("<@%s>", slackUID)
+		}
+		gained := m.Score - previousScores[m.ID]
+		line := fmt.Sprintf("%d. %s — %d pts (+%d since last check)", rank+1, who, m.Score, gained)
+		sectionText := slack.NewTextBlockObject("mrkdwn", line, false, false)
+		sectionBlocks = append(sectionBlocks, slack.NewSectionBlock(sectionText, nil, nil))
+	}
+
+	msg := slack.MsgOptionBlocks(sectionBlocks...)
+	if _, _, err := a.client.PostMessage(channelID, msg); err != nil {
+		return fmt.Errorf("PostExternalLeaderboardUpdate PostMessage: %w", err)
+	}
+	return nil
+}
+
+// HandleExternalLink attaches a Slack UID to an external member ID so future
+// PostExternalLeaderboardUpdate recaps @-mention them. Expects the
+// /external/{source}/link path and `external_id`/`slack_uid` form values.
+func (a *AdminHandlers) HandleExternalLink(w http.ResponseWriter, r *http.Request) error {
+	source := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/external/"), "/link")
+	if source == "" {
+		return errors.New("no source provided in path")
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+	externalID := r.FormValue("external_id")
+	slackUID := r.FormValue("slack_uid")
+	if externalID == "" || slackUID == "" {
+		return errors.New("external_id and slack_uid form values are required")
+	}
+
+	if err := a.ExternalLeaderboardService.LinkMember(source, externalID, slackUID); err != nil {
+		return fmt.Errorf("HandleExternalLink: %w", err)
+	}
+	return nil
+}
+
+// HandleScheduleList responds with the registered stats.ScheduleEntry values as JSON.
+func (a *AdminHandlers) HandleScheduleList(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(a.Scheduler.Entries()); err != nil {
+		return fmt.Errorf("HandleScheduleList: %w", err)
+	}
+	return nil
+}
+
+// HandleScheduleRun triggers the schedule entry named by the
+// /schedule/{name}/run path immediately, bypassing its cron expression and
+// the duplicate-run guard.
+func (a *AdminHandlers) HandleScheduleRun(w http.ResponseWriter, r *http.Request) error {
+	name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/schedule/"), "/run")
+	if name == "" {
+		return errors.New("no schedule name provided in path")
+	}
+
+	if err := a.Scheduler.RunNow(name); err != nil {
+		return fmt.Errorf("HandleScheduleRun: %w", err)
+	}
+	return nil
+}
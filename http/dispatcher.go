@@ -0,0 +1,61 @@
+package http
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/slack-go/slack/slackevents"
+)
+
+// ReactionDispatcher dispatches ReactionAddedEvent/ReactionRemovedEvent
+// envelopes to every registered stats.StatProcessor. It is shared by every
+// transport (HTTP Events API, Socket Mode, ...) so reaction handling stays
+// identical regardless of how the event reached the process.
+type ReactionDispatcher struct {
+	Registry *stats.StatRegistry
+	StatTx   stats.StatTx
+
+	logger *slog.Logger
+}
+
+// NewReactionDispatcher returns a new instance of ReactionDispatcher.
+func NewReactionDispatcher(logger *slog.Logger, registry *stats.StatRegistry, statTx stats.StatTx) *ReactionDispatcher {
+	return &ReactionDispatcher{
+		logger:   logger,
+		Registry: registry,
+		StatTx:   statTx,
+	}
+}
+
+// HandleReactionAddedEvent handles the event when a user reacts to the post of another user.
+func (d *ReactionDispatcher) HandleReactionAddedEvent(e *slackevents.ReactionAddedEvent) error {
+	if e.ItemUser == "USLACKBOT" || e.ItemUser == "" {
+		d.logger.Info("reaction to invalid target", slog.String("target slackUID", e.ItemUser), slog.String("reaction intiator", e.User))
+		return nil
+	}
+
+	for _, p := range d.Registry.Processors() {
+		if err := p.OnReactionAdded(e, d.StatTx); err != nil {
+			return fmt.Errorf("HandleReactionAddedEvent %s: %w", p.Name(), err)
+		}
+	}
+	d.logger.Info("dispatched reaction added", slog.String("slackUID", e.ItemUser), slog.String("reaction", e.Reaction))
+	return nil
+}
+
+// HandleReactionRemovedEvent handles the event when a user removes a reaction from another user's post.
+func (d *ReactionDispatcher) HandleReactionRemovedEvent(e *slackevents.ReactionRemovedEvent) error {
+	if e.ItemUser == "USLACKBOT" || e.ItemUser == "" {
+		d.logger.Info("reaction to invalid target", slog.String("target slackUID", e.ItemUser), slog.String("reaction intiator", e.User))
+		return nil
+	}
+
+	for _, p := range d.Registry.Processors() {
+		if err := p.OnReactionRemoved(e, d.StatTx); err != nil {
+			return fmt.Errorf("HandleReactionRemovedEvent %s: %w", p.Name(), err)
+		}
+	}
+	d.logger.Info("dispatched reaction removed", slog.String("slackUID", e.ItemUser), slog.String("reaction", e.Reaction))
+	return nil
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+)
+
+// Server routes the endpoints exposed by a Slacker onto a single
+// http.ServeMux and serves it on Addr.
+type Server struct {
+	server *http.Server
+	logger *slog.Logger
+
+	Addr string
+}
+
+// NewServer returns a new instance of Server routing every Slacker endpoint.
+func NewServer(logger *slog.Logger, addr string, slacker Slacker) *Server {
+	s := &Server{
+		logger: logger,
+		Addr:   addr,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/events", s.wrap(slacker.HandleEvents))
+	mux.HandleFunc("/monthly-update", s.wrap(slacker.HandleMonthlyUpdate))
+	mux.HandleFunc("/reactions/reload", s.wrap(slacker.HandleReactionsReload))
+	mux.HandleFunc("/schedule", s.wrap(slacker.HandleScheduleList))
+	mux.HandleFunc("/schedule/", s.wrap(slacker.HandleScheduleRun))
+	mux.HandleFunc("/external/", s.wrap(slacker.HandleExternalLink))
+
+	s.server = &http.Server{Addr: addr, Handler: mux}
+	return s
+}
+
+// wrap adapts an error-returning handler to http.HandlerFunc, logging and
+// responding 500 on error so every Slacker handler doesn't repeat this.
+func (s *Server) wrap(h func(w http.ResponseWriter, r *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := h(w, r); err != nil {
+			s.logger.Error("request failed", slog.String("path", r.URL.Path), slog.String("err", err.Error()))
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// Open starts the server listening on Addr in the background.
+func (s *Server) Open() error {
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("server stopped", slog.String("err", err.Error()))
+		}
+	}()
+	return nil
+}
+
+// Close gracefully shuts down the server.
+func (s *Server) Close() error {
+	return s.server.Shutdown(context.Background())
+}
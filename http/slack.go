@@ -2,7 +2,6 @@ package http
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -14,85 +13,53 @@ import (
 	"github.com/slack-go/slack/slackevents"
 )
 
-const (
-	ThumbsUp   = "+1"
-	ThumbsDown = "-1"
-)
-
 // Slacker represents a service for handling Slack push events.
 type Slacker interface {
 	HandleEvents(w http.ResponseWriter, r *http.Request) error
 	HandleMonthlyUpdate(w http.ResponseWriter, r *http.Request) error
+	HandleReactionsReload(w http.ResponseWriter, r *http.Request) error
+	HandleScheduleList(w http.ResponseWriter, r *http.Request) error
+	HandleScheduleRun(w http.ResponseWriter, r *http.Request) error
+	HandleExternalLink(w http.ResponseWriter, r *http.Request) error
+
+	// PostMonthlyUpdate, PostWeeklyUpdate, and SyncExternalLeaderboard /
+	// PostExternalLeaderboardUpdate let the Scheduler trigger a recap or poll
+	// directly, without going through the HTTP wrappers above.
+	PostMonthlyUpdate(date stats.MonthYear, channelID string) error
+	PostWeeklyUpdate(weekEnding time.Time, channelID string) error
+	SyncExternalLeaderboard(source string, date stats.MonthYear) error
+	PostExternalLeaderboardUpdate(source string, date stats.MonthYear, channelID string) error
 }
 
-// Slack represents a service for handling specific Slack events.
+// Slack represents a service for handling Slack's HTTP Events API: it
+// verifies the request signature, parses the envelope, and hands reaction
+// events to the embedded ReactionDispatcher. AdminHandlers is shared with
+// SlackSocketMode, the alternative transport.
 type Slack struct {
-	// Services used by Slack
-	LeaderboardService stats.LeaderboardService
-	MemberService      stats.MemberService
-	client             *slack.Client
+	*ReactionDispatcher
+	*AdminHandlers
+
+	MemberService stats.MemberService
 
-	// Dependencies
 	logger        *slog.Logger
 	SigningSecret string
-	ChannelID     string
 }
 
-// NewSlackService creates a new instance of slackService.
-func NewSlackService(logger *slog.Logger, ms stats.MemberService, ls stats.LeaderboardService, signingSecret string, botSigningKey string, channelID string) (Slacker, error) {
+// NewSlackService creates a new instance of slackService. registry is
+// consulted for every reaction event in registration order, each processor
+// operating against statTx.
+func NewSlackService(logger *slog.Logger, ms stats.MemberService, ls stats.LeaderboardService, wls stats.WeeklyLeaderboardService, els stats.ExternalLeaderboardService, sources map[string]stats.ExternalSource, statTx stats.StatTx, registry *stats.StatRegistry, scheduler *stats.Scheduler, scorer *stats.ReactionScorer, reloadReactions func() (map[string]stats.ReactionWeight, error), signingSecret string, botSigningKey string, channelID string) (Slacker, error) {
+	client := slack.New(botSigningKey)
 	return &Slack{
-		logger:             logger,
+		ReactionDispatcher: NewReactionDispatcher(logger, registry, statTx),
+		AdminHandlers:      NewAdminHandlers(logger, client, ls, wls, els, sources, scheduler, scorer, reloadReactions, channelID),
 		MemberService:      ms,
-		LeaderboardService: ls,
-		client:             slack.New(botSigningKey),
+		logger:             logger,
 		SigningSecret:      signingSecret,
-		ChannelID:          channelID,
 	}, nil
 }
 
-// HandleMonthlyUpdate
-func (s *Slack) HandleMonthlyUpdate(w http.ResponseWriter, r *http.Request) error {
-	err := r.ParseForm()
-	if err != nil {
-		return err
-	}
-
-	rawDate := r.FormValue("date")
-	if rawDate == "" {
-		return errors.New("no date value provided within the form")
-	}
-	date, err := stats.NewMonthYearString(rawDate)
-	if err != nil {
-		return err
-	}
-
-	leaderboard, err := s.LeaderboardService.FindLeaderboard(date)
-	if err != nil {
-		return err
-	}
-
-	var sectionBlocks []slack.Block
-	headerText := slack.NewTextBlockObject("mrkdwn", "*Monthly Stats Update*", false, false)
-	headerSection := slack.NewHeaderBlock(headerText)
-	sectionBlocks = append(sectionBlocks, headerSection)
-
-	mostLikesReceivedMembers := fmt.Sprintf("Most likes received this month (aka good boy of the month): <@%s> (%d)", leaderboard.MostReceivedLikesMember.SlackUID, leaderboard.MostReceivedLikesMember.ReceivedLikes)
-	sectionText := slack.NewTextBlockObject("mrkdwn", mostLikesReceivedMembers, false, false)
-	sectionBlocks = append(sectionBlocks, slack.NewSectionBlock(sectionText, nil, nil))
-
-	mostDislikesReceivedMembers := fmt.Sprintf("Most dislikes received this month: <@%s> (%d)", leaderboard.MostReceivedDislikesMember.SlackUID, leaderboard.MostReceivedDislikesMember.ReceivedDislikes)
-	sectionText = slack.NewTextBlockObject("mrkdwn", mostDislikesReceivedMembers, false, false)
-	sectionBlocks = append(sectionBlocks, slack.NewSectionBlock(sectionText, nil, nil))
-
-	msg := slack.MsgOptionBlocks(sectionBlocks...)
-	_, _, err = s.client.PostMessage(s.ChannelID, msg)
-	if err != nil {
-		return fmt.Errorf("WeeklyUpdate PostMessage: %w", err)
-	}
-	return nil
-}
-
-// handleEvents handles Slack push events.
+// HandleEvents handles Slack push events delivered over the public HTTP Events API.
 func (s *Slack) HandleEvents(w http.ResponseWriter, r *http.Request) error {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -145,105 +112,3 @@ func (s *Slack) HandleEvents(w http.ResponseWriter, r *http.Request) error {
 	}
 	return nil
 }
-
-// HandleReactionAddedEvent handles the event when a user reacts to the post of another user.
-func (s *Slack) HandleReactionAddedEvent(e *slackevents.ReactionAddedEvent) error {
-
-	if e.ItemUser == "USLACKBOT" || e.ItemUser == "" {
-		s.logger.Info("reaction to invalid target", slog.String("target slackUID", e.ItemUser), slog.String("reaction intiator", e.User))
-		return nil
-	}
-
-	monthYear := stats.NewMonthYear(time.Now().UTC())
-
-	// Create the member (user being reacted to) if he does not already exist within the database.
-	itemMember, err := s.MemberService.FindMember(e.ItemUser, monthYear)
-	fmt.Printf("within reaction added event err: %s", err.Error())
-	if errors.Is(err, stats.ErrNotFound) {
-		mem := &stats.Member{
-			SlackUID: e.User,
-			Date:     monthYear,
-		}
-		err := s.MemberService.CreateMember(mem)
-		if err != nil {
-			return fmt.Errorf("HandleReactionAddedEvent CreateMember itemMember: %w", err)
-		}
-		s.logger.Info("created new member", slog.String("slackUID", e.ItemUser), slog.String("date", monthYear.String()))
-		itemMember = mem
-	} else if err != nil {
-		return fmt.Errorf("HandleReactionAddedEvent FindMember ItemUser: %w", err)
-	}
-
-	// Update the reactions.
-	if e.Reaction == ThumbsUp {
-		itemMember.ReceivedLikes += 1
-	} else if e.Reaction == ThumbsDown {
-		itemMember.ReceivedDislikes += 1
-	}
-
-	// Update the stats of the User being reacted to.
-	s.MemberService.UpdateMember(itemMember.ID, stats.MemberUpdate{
-		ReceivedLikes:    &itemMember.ReceivedLikes,
-		ReceivedDislikes: &itemMember.ReceivedDislikes,
-	})
-	if err != nil {
-		return err
-	}
-	s.logger.Info("updated user", slog.String("slackUID", itemMember.SlackUID), slog.Int64("received likes", itemMember.ReceivedLikes), slog.Int64("received dislikes", itemMember.ReceivedDislikes), slog.String("reaction", e.Reaction))
-	return nil
-}
-
-// max finds the max between two int64s and returns it.
-func max(a int64, b int64) int64 {
-	if a > b {
-		return a
-	}
-	return b
-}
-
-// HandleReactionRemovedEvent handles the event when a user removes a reaction from another user's post.
-func (s *Slack) HandleReactionRemovedEvent(e *slackevents.ReactionRemovedEvent) error {
-
-	if e.ItemUser == "USLACKBOT" || e.ItemUser == "" {
-		s.logger.Info("reaction to invalid target", slog.String("target slackUID", e.ItemUser), slog.String("reaction intiator", e.User))
-		return nil
-	}
-
-	monthYear := stats.NewMonthYear(time.Now().UTC())
-
-	// Create the member (user being reacted to) if he does not already exist within the database.
-	itemMember, err := s.MemberService.FindMember(e.ItemUser, monthYear)
-	fmt.Printf("within reaction added event err: %s", err.Error())
-	if errors.Is(err, stats.ErrNotFound) {
-		mem := &stats.Member{
-			SlackUID: e.User,
-			Date:     monthYear,
-		}
-		err := s.MemberService.CreateMember(mem)
-		if err != nil {
-			return fmt.Errorf("HandleReactionAddedEvent CreateMember itemMember: %w", err)
-		}
-		s.logger.Info("created new member", slog.String("slackUID", e.ItemUser), slog.String("date", monthYear.String()))
-		itemMember = mem
-	} else if err != nil {
-		return fmt.Errorf("HandleReactionAddedEvent FindMember ItemUser: %w", err)
-	}
-
-	// Update the reactions.
-	if e.Reaction == ThumbsUp {
-		itemMember.ReceivedLikes = max(itemMember.ReceivedLikes-1, 0)
-	} else if e.Reaction == ThumbsDown {
-		itemMember.ReceivedDislikes = max(itemMember.ReceivedDislikes-1, 0)
-	}
-
-	// Update the stats of the User being reacted to.
-	err = s.MemberService.UpdateMember(itemMember.ID, stats.MemberUpdate{
-		ReceivedLikes:    &itemMember.ReceivedLikes,
-		ReceivedDislikes: &itemMember.ReceivedDislikes,
-	})
-	if err != nil {
-		return err
-	}
-	s.logger.Info("updated user", slog.String("slackUID", itemMember.SlackUID), slog.Int64("received likes", itemMember.ReceivedLikes), slog.Int64("received dislikes", itemMember.ReceivedDislikes), slog.String("reaction", e.Reaction))
-	return nil
-}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+	"github.com/slack-go/slack/socketmode"
+)
+
+// SlackSocketMode is a Slacker transport that receives events over a
+// WebSocket opened with Slack (Socket Mode) instead of Slack calling back
+// into a publicly reachable HTTP endpoint. The name is kept in line with
+// Slack (the HTTP Events API transport) for symmetry, even though events
+// don't arrive over HTTP; the admin endpoints (HandleMonthlyUpdate,
+// HandleReactionsReload) still do.
+type SlackSocketMode struct {
+	*ReactionDispatcher
+	*AdminHandlers
+
+	client *socketmode.Client
+	logger *slog.Logger
+
+	// ctx/cancel/done are set once here at construction time, not inside
+	// Run, so Close can always cancel and wait on them without racing Run's
+	// goroutine for the assignment (or silently no-oping if Close happens to
+	// run first).
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSlackSocketModeService creates a new instance of SlackSocketMode.
+// appToken is the app-level token (xapp-...) used to open the socket;
+// botSigningKey is the bot token (xoxb-...) used to call the Web API.
+func NewSlackSocketModeService(logger *slog.Logger, ms stats.MemberService, ls stats.LeaderboardService, wls stats.WeeklyLeaderboardService, els stats.ExternalLeaderboardService, sources map[string]stats.ExternalSource, statTx stats.StatTx, registry *stats.StatRegistry, scheduler *stats.Scheduler, scorer *stats.ReactionScorer, reloadReactions func() (map[string]stats.ReactionWeight, error), appToken string, botSigningKey string, channelID string) (*SlackSocketMode, error) {
+	api := slack.New(botSigningKey, slack.OptionAppLevelToken(appToken))
+	client := socketmode.New(api)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &SlackSocketMode{
+		ReactionDispatcher: NewReactionDispatcher(logger, registry, statTx),
+		AdminHandlers:      NewAdminHandlers(logger, api, ls, wls, els, sources, scheduler, scorer, reloadReactions, channelID),
+		client:             client,
+		logger:             logger,
+		ctx:                ctx,
+		cancel:             cancel,
+		done:               make(chan struct{}),
+	}, nil
+}
+
+// HandleEvents is a no-op for SlackSocketMode: events arrive over the socket
+// opened by Run, not over this HTTP endpoint. It exists so SlackSocketMode
+// still satisfies Slacker for the admin endpoints it shares with Slack.
+func (s *SlackSocketMode) HandleEvents(w http.ResponseWriter, r *http.Request) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+// Run opens the socket and dispatches reaction events to the embedded
+// ReactionDispatcher until ctx is canceled or Close is called.
+func (s *SlackSocketMode) Run(ctx context.Context) error {
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.cancel()
+		case <-s.ctx.Done():
+		}
+	}()
+
+	go func() {
+		defer close(s.done)
+		for {
+			var evt socketmode.Event
+			select {
+			case <-s.ctx.Done():
+				return
+			case e, ok := <-s.client.Events:
+				if !ok {
+					return
+				}
+				evt = e
+			}
+			if evt.Type != socketmode.EventTypeEventsAPI {
+				continue
+			}
+			eventsAPIEvent, ok := evt.Data.(slackevents.EventsAPIEvent)
+			if !ok {
+				continue
+			}
+			if evt.Request != nil {
+				s.client.Ack(*evt.Request)
+			}
+			if eventsAPIEvent.Type != slackevents.CallbackEvent {
+				continue
+			}
+
+			switch ev := eventsAPIEvent.InnerEvent.Data.(type) {
+			case *slackevents.ReactionAddedEvent:
+				if err := s.HandleReactionAddedEvent(ev); err != nil {
+					s.logger.Error("Run HandleReactionAddedEvent", slog.String("err", err.Error()))
+				}
+			case *slackevents.ReactionRemovedEvent:
+				if err := s.HandleReactionRemovedEvent(ev); err != nil {
+					s.logger.Error("Run HandleReactionRemovedEvent", slog.String("err", err.Error()))
+				}
+			}
+		}
+	}()
+
+	if err := s.client.RunContext(s.ctx); err != nil {
+		return fmt.Errorf("Run: %w", err)
+	}
+	return nil
+}
+
+// Close cancels the socket read loop and waits for it to exit.
+func (s *SlackSocketMode) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
@@ -0,0 +1,18 @@
+package stats
+
+import "time"
+
+// Leaderboard is a snapshot of standings as of Date: who received the most
+// likes and dislikes over the scope a particular LeaderboardService (or
+// WeeklyLeaderboardService) computes.
+type Leaderboard struct {
+	Date                       time.Time
+	MostReceivedLikesMember    Member
+	MostReceivedDislikesMember Member
+}
+
+// LeaderboardService computes a Leaderboard scoped to a calendar month.
+type LeaderboardService interface {
+	// FindLeaderboard returns the Leaderboard for date's calendar month.
+	FindLeaderboard(date time.Time) (*Leaderboard, error)
+}
@@ -6,16 +6,17 @@ import "time"
 // i.e. `2024-02` represents February 2024.
 type MonthYear string
 
-const monthYearLayout string = "2006-01"
+// MonthYearLayout is the time.Parse/time.Format layout backing MonthYear.
+const MonthYearLayout string = "2006-01"
 
 // NewMonthYear returns a new instance of MonthYear.
 func NewMonthYear(t time.Time) MonthYear {
-	return MonthYear(t.UTC().Format(monthYearLayout))
+	return MonthYear(t.UTC().Format(MonthYearLayout))
 }
 
 // NewMonthYearString returns a new instance of MonthYear.
 func NewMonthYearString(s string) (MonthYear, error) {
-	t, err := time.Parse(monthYearLayout, s)
+	t, err := time.Parse(MonthYearLayout, s)
 	if err != nil {
 		return "", err
 	}
@@ -67,6 +68,13 @@ type MemberService interface {
 	// UpdateMember updates a Member.
 	UpdateMember(id int64, upd MemberUpdate) error
 
+	// IncrementCounters adds likesDelta/dislikesDelta (either of which may be
+	// negative) to the ReceivedLikes/ReceivedDislikes of the member for
+	// SlackUID/date in a single transaction, creating the member first if
+	// necessary. Unlike a separate FindMember+UpdateMember pair, this can't
+	// lose an update to a concurrent call for the same member.
+	IncrementCounters(SlackUID string, date MonthYear, likesDelta int64, dislikesDelta int64) error
+
 	// DeleteMember permanently deletes a Member
 	DeleteMember(id int64) error
 }
@@ -0,0 +1,60 @@
+// Command example is a sample stats.StatProcessor plugin demonstrating the
+// extension point described in Config.PluginsDir. Build it with:
+//
+//	go build -buildmode=plugin -o rocket.so ./plugins/example
+//
+// and drop the resulting rocket.so into the configured plugins directory; it
+// is loaded automatically on startup.
+package main
+
+import (
+	"time"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/slack-go/slack/slackevents"
+)
+
+const (
+	reactionRocket = "rocket"
+	keyRocket      = "rocket"
+)
+
+// rocketProcessor counts how many times each member has received a :rocket: reaction.
+type rocketProcessor struct{}
+
+// New is the symbol the plugin loader looks up. Every plugin must export a
+// function with this exact name and signature.
+func New() stats.StatProcessor {
+	return &rocketProcessor{}
+}
+
+// Name returns the unique identifier of this processor.
+func (p *rocketProcessor) Name() string {
+	return "plugin.rocket"
+}
+
+// Keys returns the counter keys owned by this processor.
+func (p *rocketProcessor) Keys() []string {
+	return []string{keyRocket}
+}
+
+// OnReactionAdded increments the rocket counter for the reacted-to member.
+func (p *rocketProcessor) OnReactionAdded(ev *slackevents.ReactionAddedEvent, tx stats.StatTx) error {
+	if ev.Reaction != reactionRocket {
+		return nil
+	}
+	return tx.IncrementCounter(ev.ItemUser, stats.NewMonthYear(time.Now().UTC()), keyRocket, 1)
+}
+
+// OnReactionRemoved decrements the rocket counter for the reacted-to member.
+func (p *rocketProcessor) OnReactionRemoved(ev *slackevents.ReactionRemovedEvent, tx stats.StatTx) error {
+	if ev.Reaction != reactionRocket {
+		return nil
+	}
+	return tx.IncrementCounter(ev.ItemUser, stats.NewMonthYear(time.Now().UTC()), keyRocket, -1)
+}
+
+// OnMessage is a no-op; rocketProcessor only derives counters from reactions.
+func (p *rocketProcessor) OnMessage(ev *slackevents.MessageEvent, tx stats.StatTx) error {
+	return nil
+}
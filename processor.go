@@ -0,0 +1,74 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/slack-go/slack/slackevents"
+)
+
+// StatTx represents the persistence operations available to a StatProcessor
+// while it reacts to a single Slack event. Implementations are free to route
+// individual keys to dedicated columns (for backwards compatibility) or to a
+// generic side table, as long as the read-your-writes semantics below hold.
+type StatTx interface {
+	// Counter returns the current value of key for slackUID within date, or 0
+	// if no value has been recorded yet.
+	Counter(slackUID string, date MonthYear, key string) (int64, error)
+
+	// SetCounter sets the value of key for slackUID within date.
+	SetCounter(slackUID string, date MonthYear, key string, value int64) error
+
+	// IncrementCounter adds delta (which may be negative) to the current
+	// value of key for slackUID within date.
+	IncrementCounter(slackUID string, date MonthYear, key string, delta int64) error
+}
+
+// StatProcessor is a pluggable unit of logic that derives per-member counters
+// from Slack events. Built-in processors are compiled directly into the
+// binary; third-party ones are loaded from the directory named by
+// Config.PluginsDir via the Go plugin package.
+type StatProcessor interface {
+	// Name returns a stable identifier for this processor, unique across the
+	// StatRegistry it is registered with.
+	Name() string
+
+	// Keys returns the counter keys this processor owns.
+	Keys() []string
+
+	// OnReactionAdded is invoked when a user reacts to another member's message.
+	OnReactionAdded(ev *slackevents.ReactionAddedEvent, tx StatTx) error
+
+	// OnReactionRemoved is invoked when a user removes a reaction from another member's message.
+	OnReactionRemoved(ev *slackevents.ReactionRemovedEvent, tx StatTx) error
+
+	// OnMessage is invoked when a new message is posted.
+	OnMessage(ev *slackevents.MessageEvent, tx StatTx) error
+}
+
+// StatRegistry holds the StatProcessors the Slack service dispatches events
+// to, in registration order.
+type StatRegistry struct {
+	processors []StatProcessor
+}
+
+// NewStatRegistry returns a new, empty StatRegistry.
+func NewStatRegistry() *StatRegistry {
+	return &StatRegistry{}
+}
+
+// Register adds p to the registry.
+// Returns ErrInvalid if a processor with the same Name is already registered.
+func (r *StatRegistry) Register(p StatProcessor) error {
+	for _, existing := range r.processors {
+		if existing.Name() == p.Name() {
+			return fmt.Errorf("Register: processor %q already registered: %w", p.Name(), ErrInvalid)
+		}
+	}
+	r.processors = append(r.processors, p)
+	return nil
+}
+
+// Processors returns the registered processors in registration order.
+func (r *StatRegistry) Processors() []StatProcessor {
+	return r.processors
+}
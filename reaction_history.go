@@ -0,0 +1,29 @@
+package stats
+
+// ReactionDelta is the signed delta that was applied to a member's counter
+// the moment a reaction was added. It is recorded so that a later
+// ReactionRemoved event can replay the exact negation even if the
+// ReactionScorer's weights have since changed via /reactions/reload. Keyed by
+// the reacted-to message's own timestamp plus the reactor and the reaction
+// name, since that triple stays the same across the add and remove events for
+// one reaction instance, unlike each event's own (per-occurrence) timestamp.
+type ReactionDelta struct {
+	ItemTS   string
+	User     string
+	ItemUser string
+	Reaction string
+	Category string
+	Weight   int64
+}
+
+// ReactionHistoryService persists the ReactionDelta applied for each
+// (ItemTS, User, Reaction) triple.
+type ReactionHistoryService interface {
+	// RecordDelta stores d, keyed by (d.ItemTS, d.User, d.Reaction). Recording
+	// the same key twice overwrites the prior record.
+	RecordDelta(d ReactionDelta) error
+
+	// FindDelta retrieves the ReactionDelta recorded for (itemTS, user, reaction).
+	// Returns ErrNotFound if no matching record exists.
+	FindDelta(itemTS string, user string, reaction string) (*ReactionDelta, error)
+}
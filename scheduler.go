@@ -0,0 +1,143 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// ScheduleEntry describes one scheduled recurring action, e.g.
+// {"name":"monthly_recap","cron":"0 9 1 * *","action":"monthly_update","channel":"C123"}.
+type ScheduleEntry struct {
+	Name    string `json:"name"`
+	Cron    string `json:"cron"`
+	Action  string `json:"action"`
+	Channel string `json:"channel"`
+}
+
+// ScheduleAction runs the side effect (e.g. posting a recap) for entry.
+type ScheduleAction func(entry ScheduleEntry) error
+
+// ScheduleRunService persists the last time a named schedule entry fired, so
+// a process restart doesn't cause a duplicate post within the same minute.
+type ScheduleRunService interface {
+	// LastRun returns the last time name fired, or the zero time if it has never run.
+	LastRun(name string) (time.Time, error)
+
+	// RecordRun records that name fired at t.
+	RecordRun(name string, t time.Time) error
+}
+
+// Scheduler fires registered ScheduleEntries on their cron schedule against a
+// named set of ScheduleActions (looked up by ScheduleEntry.Action).
+type Scheduler struct {
+	cron   *cron.Cron
+	runs   ScheduleRunService
+	logger *slog.Logger
+
+	mu      sync.RWMutex
+	actions map[string]ScheduleAction
+	entries map[string]ScheduleEntry
+}
+
+// NewScheduler returns a new, unstarted Scheduler.
+func NewScheduler(logger *slog.Logger, runs ScheduleRunService) *Scheduler {
+	return &Scheduler{
+		cron:    cron.New(),
+		runs:    runs,
+		logger:  logger,
+		actions: make(map[string]ScheduleAction),
+		entries: make(map[string]ScheduleEntry),
+	}
+}
+
+// RegisterAction binds name (as referenced by ScheduleEntry.Action) to fn.
+func (s *Scheduler) RegisterAction(name string, fn ScheduleAction) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.actions[name] = fn
+}
+
+// Schedule adds entry to the cron schedule.
+// Returns ErrInvalid if entry.Action has no registered ScheduleAction, or if
+// entry.Name is already scheduled.
+func (s *Scheduler) Schedule(entry ScheduleEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn, ok := s.actions[entry.Action]
+	if !ok {
+		return fmt.Errorf("Schedule: unknown action %q: %w", entry.Action, ErrInvalid)
+	}
+	if _, exists := s.entries[entry.Name]; exists {
+		return fmt.Errorf("Schedule: entry %q already scheduled: %w", entry.Name, ErrInvalid)
+	}
+
+	if _, err := s.cron.AddFunc(entry.Cron, func() { s.run(entry, fn) }); err != nil {
+		return fmt.Errorf("Schedule AddFunc %s: %w", entry.Name, err)
+	}
+	s.entries[entry.Name] = entry
+	return nil
+}
+
+// run guards against duplicate posts within the same minute across restarts
+// by consulting ScheduleRunService before invoking fn.
+func (s *Scheduler) run(entry ScheduleEntry, fn ScheduleAction) {
+	now := time.Now().UTC()
+	if last, err := s.runs.LastRun(entry.Name); err == nil && !last.IsZero() && now.Truncate(time.Minute).Equal(last.Truncate(time.Minute)) {
+		return
+	}
+	if err := fn(entry); err != nil {
+		s.logger.Error("scheduled action failed", slog.String("entry", entry.Name), slog.String("action", entry.Action), slog.String("err", err.Error()))
+		return
+	}
+	if err := s.runs.RecordRun(entry.Name, now); err != nil {
+		s.logger.Error("failed to record schedule run", slog.String("entry", entry.Name), slog.String("err", err.Error()))
+	}
+}
+
+// RunNow runs name's action immediately, bypassing both its cron schedule and
+// the duplicate-run guard. Used by the POST /schedule/{name}/run admin endpoint.
+func (s *Scheduler) RunNow(name string) error {
+	s.mu.RLock()
+	entry, ok := s.entries[name]
+	var fn ScheduleAction
+	if ok {
+		fn, ok = s.actions[entry.Action]
+	}
+	s.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("RunNow: unknown schedule %q: %w", name, ErrNotFound)
+	}
+
+	if err := fn(entry); err != nil {
+		return fmt.Errorf("RunNow %s: %w", name, err)
+	}
+	return s.runs.RecordRun(name, time.Now().UTC())
+}
+
+// Entries returns the registered ScheduleEntries.
+func (s *Scheduler) Entries() []ScheduleEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]ScheduleEntry, 0, len(s.entries))
+	for _, e := range s.entries {
+		out = append(out, e)
+	}
+	return out
+}
+
+// Start begins firing scheduled entries in the background.
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
+// Stop halts the scheduler. The returned context is done once the last
+// running job has completed.
+func (s *Scheduler) Stop() context.Context {
+	return s.cron.Stop()
+}
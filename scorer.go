@@ -0,0 +1,59 @@
+package stats
+
+import "sync"
+
+// ReactionWeight describes how a single emoji name contributes to a scored
+// category, e.g. {"likes", 2} for "tada".
+type ReactionWeight struct {
+	Category string `json:"category"`
+	Weight   int64  `json:"weight"`
+}
+
+// ReactionScorer maps Slack reaction names (without the surrounding colons)
+// to a scored category and signed weight. It is safe for concurrent use so
+// that a config reload doesn't race with in-flight event dispatch.
+type ReactionScorer struct {
+	mu      sync.RWMutex
+	weights map[string]ReactionWeight
+}
+
+// NewReactionScorer returns a new ReactionScorer backed by weights.
+func NewReactionScorer(weights map[string]ReactionWeight) *ReactionScorer {
+	return &ReactionScorer{weights: weights}
+}
+
+// Score returns the category and signed weight configured for reaction, and
+// ok == false if reaction is not tracked at all.
+func (rs *ReactionScorer) Score(reaction string) (category string, weight int64, ok bool) {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	w, ok := rs.weights[reaction]
+	if !ok {
+		return "", 0, false
+	}
+	return w.Category, w.Weight, true
+}
+
+// Categories returns the distinct counter categories this scorer can produce,
+// i.e. the Keys() a StatProcessor built on top of it should report.
+func (rs *ReactionScorer) Categories() []string {
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	seen := make(map[string]struct{}, len(rs.weights))
+	var categories []string
+	for _, w := range rs.weights {
+		if _, ok := seen[w.Category]; ok {
+			continue
+		}
+		seen[w.Category] = struct{}{}
+		categories = append(categories, w.Category)
+	}
+	return categories
+}
+
+// Reload atomically swaps in a new set of weights, e.g. after a config re-read.
+func (rs *ReactionScorer) Reload(weights map[string]ReactionWeight) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.weights = weights
+}
@@ -0,0 +1,43 @@
+package stats
+
+import (
+	"sync"
+	"testing"
+)
+
+// TestReactionScorer_ReloadRace exercises Score/Categories/Reload
+// concurrently so `go test -race` catches a regression in the locking that
+// keeps a config reload from racing in-flight event dispatch.
+func TestReactionScorer_ReloadRace(t *testing.T) {
+	rs := NewReactionScorer(map[string]ReactionWeight{
+		"+1": {Category: "likes", Weight: 1},
+		"-1": {Category: "dislikes", Weight: 1},
+	})
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if i%2 == 0 {
+				rs.Reload(map[string]ReactionWeight{"+1": {Category: "likes", Weight: 1}})
+			} else {
+				rs.Reload(map[string]ReactionWeight{"+1": {Category: "likes", Weight: 2}, "-1": {Category: "dislikes", Weight: 1}})
+			}
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		rs.Score("+1")
+		rs.Categories()
+	}
+	close(stop)
+	wg.Wait()
+}
@@ -0,0 +1,71 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ddritzenhoff/stats/sqlite/gen"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// DB wraps a *sql.DB together with the gen.Queries bound to it, and applies
+// the schema every gen file contributes on Open.
+type DB struct {
+	db    *sql.DB
+	query *gen.Queries
+
+	// DSN is the data source name passed to sql.Open, e.g. a file path or
+	// ":memory:".
+	DSN string
+}
+
+// NewDB returns a new instance of DB for DSN. Call Open before using it.
+func NewDB(dsn string) *DB {
+	return &DB{DSN: dsn}
+}
+
+// Open opens the underlying sqlite3 connection and applies the schema.
+func (db *DB) Open() error {
+	if db.DSN == "" {
+		return fmt.Errorf("Open: DSN required")
+	}
+
+	d, err := sql.Open("sqlite3", db.DSN)
+	if err != nil {
+		return fmt.Errorf("Open: %w", err)
+	}
+	db.db = d
+
+	// Every connection to ":memory:" opens its own empty database, so keep a
+	// single connection for the schema and all later queries to share.
+	if db.DSN == ":memory:" {
+		db.db.SetMaxOpenConns(1)
+	}
+
+	if err := gen.Migrate(db.db); err != nil {
+		db.db.Close()
+		return fmt.Errorf("Open Migrate: %w", err)
+	}
+	db.query = gen.New(db.db)
+	return nil
+}
+
+// Close closes the underlying sqlite3 connection.
+func (db *DB) Close() error {
+	if db.db == nil {
+		return nil
+	}
+	return db.db.Close()
+}
+
+// BeginTx starts a Tx wrapping a *sql.Tx on this connection, with its
+// gen.Queries bound via WithTx and Now() frozen to the moment it's called.
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	tx, err := db.db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewTx(tx, db.query, time.Now()), nil
+}
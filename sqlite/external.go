@@ -0,0 +1,141 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/ddritzenhoff/stats/sqlite/gen"
+)
+
+// Ensure ExternalLeaderboardService implements interface.
+var _ stats.ExternalLeaderboardService = (*ExternalLeaderboardService)(nil)
+
+// ExternalLeaderboardService persists external_leaderboard_snapshots (one
+// JSON-encoded snapshot per source/month) and member_external_links
+// (slack_uid, external_source, external_id).
+type ExternalLeaderboardService struct {
+	db *DB
+}
+
+// NewExternalLeaderboardService returns a new instance of ExternalLeaderboardService.
+func NewExternalLeaderboardService(db *DB) *ExternalLeaderboardService {
+	return &ExternalLeaderboardService{db: db}
+}
+
+// externalMemberRow mirrors stats.ExternalMember for JSON (de)serialization
+// into the snapshot's members_json column.
+type externalMemberRow struct {
+	ID             string `json:"id"`
+	DisplayName    string `json:"displayName"`
+	Score          int64  `json:"score"`
+	LastProgressAt string `json:"lastProgressAt"`
+}
+
+// SaveSnapshot stores snapshot, keyed by (snapshot.Source, snapshot.Date).
+func (es *ExternalLeaderboardService) SaveSnapshot(snapshot stats.ExternalLeaderboardSnapshot) error {
+	tx, err := es.db.BeginTx(context.TODO(), nil)
+	if err != nil {
+		return fmt.Errorf("SaveSnapshot: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows := make([]externalMemberRow, len(snapshot.Members))
+	for i, m := range snapshot.Members {
+		rows[i] = externalMemberRow{
+			ID:             m.ID,
+			DisplayName:    m.DisplayName,
+			Score:          m.Score,
+			LastProgressAt: m.LastProgressAt.UTC().Format(time.RFC3339),
+		}
+	}
+	membersJSON, err := json.Marshal(rows)
+	if err != nil {
+		return fmt.Errorf("SaveSnapshot Marshal: %w", err)
+	}
+
+	if err := tx.Queries().UpsertExternalSnapshot(context.TODO(), gen.UpsertExternalSnapshotParams{
+		Source:      snapshot.Source,
+		MonthYear:   snapshot.Date.String(),
+		MembersJson: string(membersJSON),
+	}); err != nil {
+		return fmt.Errorf("SaveSnapshot UpsertExternalSnapshot: %w", err)
+	}
+	return tx.Commit()
+}
+
+// FindSnapshot retrieves the snapshot saved for (source, date).
+// Returns ErrNotFound if no matching snapshot exists.
+func (es *ExternalLeaderboardService) FindSnapshot(source string, date stats.MonthYear) (*stats.ExternalLeaderboardSnapshot, error) {
+	genSnapshot, err := es.db.query.FindExternalSnapshot(context.TODO(), gen.FindExternalSnapshotParams{
+		Source:    source,
+		MonthYear: date.String(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, stats.ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("FindSnapshot: %w", err)
+	}
+
+	var rows []externalMemberRow
+	if err := json.Unmarshal([]byte(genSnapshot.MembersJson), &rows); err != nil {
+		return nil, fmt.Errorf("FindSnapshot Unmarshal: %w", err)
+	}
+
+	members := make([]stats.ExternalMember, len(rows))
+	for i, row := range rows {
+		lastProgressAt, err := time.Parse(time.RFC3339, row.LastProgressAt)
+		if err != nil {
+			return nil, fmt.Errorf("FindSnapshot Parse: %w", err)
+		}
+		members[i] = stats.ExternalMember{
+			ID:             row.ID,
+			DisplayName:    row.DisplayName,
+			Score:          row.Score,
+			LastProgressAt: lastProgressAt,
+		}
+	}
+
+	return &stats.ExternalLeaderboardSnapshot{
+		Source:  source,
+		Date:    date,
+		Members: members,
+	}, nil
+}
+
+// LinkMember attaches slackUID to externalID within source.
+func (es *ExternalLeaderboardService) LinkMember(source string, externalID string, slackUID string) error {
+	tx, err := es.db.BeginTx(context.TODO(), nil)
+	if err != nil {
+		return fmt.Errorf("LinkMember: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := tx.Queries().UpsertExternalLink(context.TODO(), gen.UpsertExternalLinkParams{
+		SlackUid:       slackUID,
+		ExternalSource: source,
+		ExternalId:     externalID,
+	}); err != nil {
+		return fmt.Errorf("LinkMember UpsertExternalLink: %w", err)
+	}
+	return tx.Commit()
+}
+
+// FindSlackUID returns the Slack UID linked to externalID within source.
+// Returns ErrNotFound if externalID has no link.
+func (es *ExternalLeaderboardService) FindSlackUID(source string, externalID string) (string, error) {
+	genLink, err := es.db.query.FindExternalLink(context.TODO(), gen.FindExternalLinkParams{
+		ExternalSource: source,
+		ExternalId:     externalID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", stats.ErrNotFound
+	} else if err != nil {
+		return "", fmt.Errorf("FindSlackUID: %w", err)
+	}
+	return genLink.SlackUid, nil
+}
@@ -0,0 +1,118 @@
+package gen
+
+import "context"
+
+// externalSchema creates the tables backing ExternalLeaderboardService:
+// external_leaderboard_snapshots stores one JSON-encoded snapshot per
+// (source, month_year), and member_external_links maps a Slack UID to its
+// external member id within a source. Applied by DB.Open alongside the rest
+// of the schema.
+const externalSchema = `
+CREATE TABLE IF NOT EXISTS external_leaderboard_snapshots (
+	source       TEXT NOT NULL,
+	month_year   TEXT NOT NULL,
+	members_json TEXT NOT NULL,
+	PRIMARY KEY (source, month_year)
+);
+
+CREATE TABLE IF NOT EXISTS member_external_links (
+	slack_uid       TEXT NOT NULL,
+	external_source TEXT NOT NULL,
+	external_id     TEXT NOT NULL,
+	PRIMARY KEY (external_source, external_id)
+);
+`
+
+const upsertExternalSnapshot = `
+INSERT INTO external_leaderboard_snapshots (source, month_year, members_json)
+VALUES (?, ?, ?)
+ON CONFLICT (source, month_year) DO UPDATE SET members_json = excluded.members_json
+`
+
+// UpsertExternalSnapshotParams holds the arguments to UpsertExternalSnapshot.
+type UpsertExternalSnapshotParams struct {
+	Source      string
+	MonthYear   string
+	MembersJson string
+}
+
+// UpsertExternalSnapshot stores the snapshot for (Source, MonthYear).
+func (q *Queries) UpsertExternalSnapshot(ctx context.Context, arg UpsertExternalSnapshotParams) error {
+	_, err := q.db.ExecContext(ctx, upsertExternalSnapshot, arg.Source, arg.MonthYear, arg.MembersJson)
+	return err
+}
+
+const findExternalSnapshot = `
+SELECT source, month_year, members_json
+FROM external_leaderboard_snapshots
+WHERE source = ? AND month_year = ?
+`
+
+// ExternalLeaderboardSnapshot is the row type returned by FindExternalSnapshot.
+type ExternalLeaderboardSnapshot struct {
+	Source      string
+	MonthYear   string
+	MembersJson string
+}
+
+// FindExternalSnapshotParams holds the arguments to FindExternalSnapshot.
+type FindExternalSnapshotParams struct {
+	Source    string
+	MonthYear string
+}
+
+// FindExternalSnapshot returns the snapshot saved for (Source, MonthYear).
+// Returns sql.ErrNoRows if none exists.
+func (q *Queries) FindExternalSnapshot(ctx context.Context, arg FindExternalSnapshotParams) (ExternalLeaderboardSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, findExternalSnapshot, arg.Source, arg.MonthYear)
+	var s ExternalLeaderboardSnapshot
+	err := row.Scan(&s.Source, &s.MonthYear, &s.MembersJson)
+	return s, err
+}
+
+const upsertExternalLink = `
+INSERT INTO member_external_links (slack_uid, external_source, external_id)
+VALUES (?, ?, ?)
+ON CONFLICT (external_source, external_id) DO UPDATE SET slack_uid = excluded.slack_uid
+`
+
+// UpsertExternalLinkParams holds the arguments to UpsertExternalLink.
+type UpsertExternalLinkParams struct {
+	SlackUid       string
+	ExternalSource string
+	ExternalId     string
+}
+
+// UpsertExternalLink attaches SlackUid to ExternalId within ExternalSource.
+func (q *Queries) UpsertExternalLink(ctx context.Context, arg UpsertExternalLinkParams) error {
+	_, err := q.db.ExecContext(ctx, upsertExternalLink, arg.SlackUid, arg.ExternalSource, arg.ExternalId)
+	return err
+}
+
+const findExternalLink = `
+SELECT slack_uid, external_source, external_id
+FROM member_external_links
+WHERE external_source = ? AND external_id = ?
+`
+
+// ExternalLink is the row type returned by FindExternalLink.
+type ExternalLink struct {
+	SlackUid       string
+	ExternalSource string
+	ExternalId     string
+}
+
+// FindExternalLinkParams holds the arguments to FindExternalLink.
+type FindExternalLinkParams struct {
+	ExternalSource string
+	ExternalId     string
+}
+
+// FindExternalLink returns the link recorded for (ExternalSource, ExternalId).
+// Returns sql.ErrNoRows if externalId has no link.
+func (q *Queries) FindExternalLink(ctx context.Context, arg FindExternalLinkParams) (ExternalLink, error) {
+	row := q.db.QueryRowContext(ctx, findExternalLink, arg.ExternalSource, arg.ExternalId)
+	var l ExternalLink
+	err := row.Scan(&l.SlackUid, &l.ExternalSource, &l.ExternalId)
+	return l, err
+}
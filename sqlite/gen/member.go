@@ -0,0 +1,178 @@
+package gen
+
+import (
+	"context"
+	"database/sql"
+)
+
+// DBTX is satisfied by both *sql.DB and *sql.Tx, letting a Queries run
+// directly against the database or, via WithTx, bound to a transaction.
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Queries wraps a DBTX with the generated query methods in this package.
+type Queries struct {
+	db DBTX
+}
+
+// New returns a new Queries running directly against db.
+func New(db DBTX) *Queries {
+	return &Queries{db: db}
+}
+
+// WithTx returns a copy of q bound to tx instead of its original DBTX.
+func (q *Queries) WithTx(tx *sql.Tx) *Queries {
+	return &Queries{db: tx}
+}
+
+// schema is the concatenation of every table this package manages.
+// Migrate applies it.
+const schema = memberSchema + memberStatSchema + reactionDeltaSchema + scheduleRunSchema + externalSchema
+
+// Migrate creates every table this package manages, if it doesn't already exist.
+func Migrate(db DBTX) error {
+	_, err := db.ExecContext(context.Background(), schema)
+	return err
+}
+
+// memberSchema creates the members table: one row per (slack_uid,
+// month_year) carrying the legacy cumulative ReceivedLikes/ReceivedDislikes
+// counters. Applied by Migrate alongside the rest of the schema.
+const memberSchema = `
+CREATE TABLE IF NOT EXISTS members (
+	id                INTEGER PRIMARY KEY AUTOINCREMENT,
+	slack_uid         TEXT    NOT NULL,
+	month_year        TEXT    NOT NULL,
+	received_likes    INTEGER NOT NULL DEFAULT 0,
+	received_dislikes INTEGER NOT NULL DEFAULT 0,
+	created_at        TEXT    NOT NULL,
+	updated_at        TEXT    NOT NULL,
+	UNIQUE (slack_uid, month_year)
+);
+`
+
+// Member is the row type returned by the member queries in this file.
+type Member struct {
+	ID               int64
+	SlackUid         string
+	MonthYear        string
+	ReceivedLikes    int64
+	ReceivedDislikes int64
+	CreatedAt        string
+	UpdatedAt        string
+}
+
+const findMemberByID = `
+SELECT id, slack_uid, month_year, received_likes, received_dislikes, created_at, updated_at
+FROM members WHERE id = ?
+`
+
+// FindMemberByID returns the member row with id.
+// Returns sql.ErrNoRows if no such row exists.
+func (q *Queries) FindMemberByID(ctx context.Context, id int64) (Member, error) {
+	row := q.db.QueryRowContext(ctx, findMemberByID, id)
+	var m Member
+	err := row.Scan(&m.ID, &m.SlackUid, &m.MonthYear, &m.ReceivedLikes, &m.ReceivedDislikes, &m.CreatedAt, &m.UpdatedAt)
+	return m, err
+}
+
+const findMember = `
+SELECT id, slack_uid, month_year, received_likes, received_dislikes, created_at, updated_at
+FROM members WHERE slack_uid = ? AND month_year = ?
+`
+
+// FindMemberParams holds the arguments to FindMember.
+type FindMemberParams struct {
+	SlackUid  string
+	MonthYear string
+}
+
+// FindMember returns the member row for (SlackUid, MonthYear).
+// Returns sql.ErrNoRows if no such row exists.
+func (q *Queries) FindMember(ctx context.Context, arg FindMemberParams) (Member, error) {
+	row := q.db.QueryRowContext(ctx, findMember, arg.SlackUid, arg.MonthYear)
+	var m Member
+	err := row.Scan(&m.ID, &m.SlackUid, &m.MonthYear, &m.ReceivedLikes, &m.ReceivedDislikes, &m.CreatedAt, &m.UpdatedAt)
+	return m, err
+}
+
+const createMember = `
+INSERT INTO members (slack_uid, month_year, created_at, updated_at)
+VALUES (?, ?, ?, ?)
+RETURNING id, slack_uid, month_year, received_likes, received_dislikes, created_at, updated_at
+`
+
+// CreateMemberParams holds the arguments to CreateMember.
+type CreateMemberParams struct {
+	SlackUid  string
+	MonthYear string
+	CreatedAt string
+	UpdatedAt string
+}
+
+// CreateMember inserts a new member row for (SlackUid, MonthYear) with its
+// counters at zero.
+func (q *Queries) CreateMember(ctx context.Context, arg CreateMemberParams) (Member, error) {
+	row := q.db.QueryRowContext(ctx, createMember, arg.SlackUid, arg.MonthYear, arg.CreatedAt, arg.UpdatedAt)
+	var m Member
+	err := row.Scan(&m.ID, &m.SlackUid, &m.MonthYear, &m.ReceivedLikes, &m.ReceivedDislikes, &m.CreatedAt, &m.UpdatedAt)
+	return m, err
+}
+
+const updateMember = `
+UPDATE members SET received_likes = ?, received_dislikes = ?, updated_at = ? WHERE id = ?
+`
+
+// UpdateMemberParams holds the arguments to UpdateMember.
+type UpdateMemberParams struct {
+	ReceivedLikes    int64
+	ReceivedDislikes int64
+	UpdatedAt        string
+	ID               int64
+}
+
+// UpdateMember overwrites the counters and UpdatedAt of the member row with ID.
+func (q *Queries) UpdateMember(ctx context.Context, arg UpdateMemberParams) error {
+	_, err := q.db.ExecContext(ctx, updateMember, arg.ReceivedLikes, arg.ReceivedDislikes, arg.UpdatedAt, arg.ID)
+	return err
+}
+
+const deleteMember = `DELETE FROM members WHERE id = ?`
+
+// DeleteMember permanently deletes the member row with id.
+func (q *Queries) DeleteMember(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteMember, id)
+	return err
+}
+
+const mostLikesReceived = `
+SELECT id, slack_uid, month_year, received_likes, received_dislikes, created_at, updated_at
+FROM members WHERE month_year = ? ORDER BY received_likes DESC LIMIT 1
+`
+
+// MostLikesReceived returns the member row with the highest ReceivedLikes
+// within monthYear. Returns sql.ErrNoRows if monthYear has no members.
+func (q *Queries) MostLikesReceived(ctx context.Context, monthYear string) (Member, error) {
+	row := q.db.QueryRowContext(ctx, mostLikesReceived, monthYear)
+	var m Member
+	err := row.Scan(&m.ID, &m.SlackUid, &m.MonthYear, &m.ReceivedLikes, &m.ReceivedDislikes, &m.CreatedAt, &m.UpdatedAt)
+	return m, err
+}
+
+const mostDislikesReceived = `
+SELECT id, slack_uid, month_year, received_likes, received_dislikes, created_at, updated_at
+FROM members WHERE month_year = ? ORDER BY received_dislikes DESC LIMIT 1
+`
+
+// MostDislikesReceived returns the member row with the highest
+// ReceivedDislikes within monthYear. Returns sql.ErrNoRows if monthYear has
+// no members.
+func (q *Queries) MostDislikesReceived(ctx context.Context, monthYear string) (Member, error) {
+	row := q.db.QueryRowContext(ctx, mostDislikesReceived, monthYear)
+	var m Member
+	err := row.Scan(&m.ID, &m.SlackUid, &m.MonthYear, &m.ReceivedLikes, &m.ReceivedDislikes, &m.CreatedAt, &m.UpdatedAt)
+	return m, err
+}
@@ -0,0 +1,36 @@
+package gen
+
+import "context"
+
+const incrementMemberCounters = `
+INSERT INTO members (slack_uid, month_year, received_likes, received_dislikes, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (slack_uid, month_year) DO UPDATE SET
+	received_likes    = members.received_likes + excluded.received_likes,
+	received_dislikes = members.received_dislikes + excluded.received_dislikes,
+	updated_at        = excluded.updated_at
+RETURNING id, slack_uid, month_year, received_likes, received_dislikes, created_at, updated_at
+`
+
+// IncrementMemberCountersParams holds the arguments to IncrementMemberCounters.
+type IncrementMemberCountersParams struct {
+	SlackUid         string
+	MonthYear        string
+	ReceivedLikes    int64
+	ReceivedDislikes int64
+	CreatedAt        string
+	UpdatedAt        string
+}
+
+// IncrementMemberCounters adds ReceivedLikes/ReceivedDislikes to the member
+// row for (SlackUid, MonthYear) in a single statement, creating the row with
+// CreatedAt/UpdatedAt if it doesn't exist yet. Relies on the same
+// UNIQUE(slack_uid, month_year) constraint FindMember depends on, so two
+// concurrent calls for the same member serialize at the database instead of
+// racing a separate FindMember+UpdateMember pair into a lost update.
+func (q *Queries) IncrementMemberCounters(ctx context.Context, arg IncrementMemberCountersParams) (Member, error) {
+	row := q.db.QueryRowContext(ctx, incrementMemberCounters, arg.SlackUid, arg.MonthYear, arg.ReceivedLikes, arg.ReceivedDislikes, arg.CreatedAt, arg.UpdatedAt)
+	var m Member
+	err := row.Scan(&m.ID, &m.SlackUid, &m.MonthYear, &m.ReceivedLikes, &m.ReceivedDislikes, &m.CreatedAt, &m.UpdatedAt)
+	return m, err
+}
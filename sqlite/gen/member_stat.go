@@ -0,0 +1,59 @@
+package gen
+
+import "context"
+
+// memberStatSchema creates the member_stats side table backing StatService
+// for every counter key other than the legacy "likes"/"dislikes" columns on
+// members, so a new StatProcessor never requires a schema change. Applied by
+// DB.Open alongside the rest of the schema.
+const memberStatSchema = `
+CREATE TABLE IF NOT EXISTS member_stats (
+	slack_uid  TEXT    NOT NULL,
+	month_year TEXT    NOT NULL,
+	key        TEXT    NOT NULL,
+	value      INTEGER NOT NULL DEFAULT 0,
+	PRIMARY KEY (slack_uid, month_year, key)
+);
+`
+
+const upsertMemberStat = `
+INSERT INTO member_stats (slack_uid, month_year, key, value)
+VALUES (?, ?, ?, ?)
+ON CONFLICT (slack_uid, month_year, key) DO UPDATE SET
+	value = member_stats.value + excluded.value
+`
+
+// UpsertMemberStatParams holds the arguments to UpsertMemberStat.
+type UpsertMemberStatParams struct {
+	SlackUid  string
+	MonthYear string
+	Key       string
+	Value     int64
+}
+
+// UpsertMemberStat adds Value to the counter for (SlackUid, MonthYear, Key),
+// creating the row if it doesn't already exist.
+func (q *Queries) UpsertMemberStat(ctx context.Context, arg UpsertMemberStatParams) error {
+	_, err := q.db.ExecContext(ctx, upsertMemberStat, arg.SlackUid, arg.MonthYear, arg.Key, arg.Value)
+	return err
+}
+
+const findMemberStat = `
+SELECT value FROM member_stats WHERE slack_uid = ? AND month_year = ? AND key = ?
+`
+
+// FindMemberStatParams holds the arguments to FindMemberStat.
+type FindMemberStatParams struct {
+	SlackUid  string
+	MonthYear string
+	Key       string
+}
+
+// FindMemberStat returns the current value of the counter for
+// (SlackUid, MonthYear, Key). Returns sql.ErrNoRows if unset.
+func (q *Queries) FindMemberStat(ctx context.Context, arg FindMemberStatParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, findMemberStat, arg.SlackUid, arg.MonthYear, arg.Key)
+	var value int64
+	err := row.Scan(&value)
+	return value, err
+}
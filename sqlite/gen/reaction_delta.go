@@ -0,0 +1,110 @@
+package gen
+
+import "context"
+
+// reactionDeltaSchema creates the reaction_deltas table backing
+// ReactionHistoryService. Applied by DB.Open alongside the rest of the
+// schema.
+const reactionDeltaSchema = `
+CREATE TABLE IF NOT EXISTS reaction_deltas (
+	item_ts    TEXT    NOT NULL,
+	slack_uid  TEXT    NOT NULL,
+	item_uid   TEXT    NOT NULL,
+	reaction   TEXT    NOT NULL,
+	category   TEXT    NOT NULL,
+	weight     INTEGER NOT NULL,
+	created_at TEXT    NOT NULL,
+	PRIMARY KEY (item_ts, slack_uid, reaction)
+);
+`
+
+// ReactionDelta is the row type returned by FindReactionDelta.
+type ReactionDelta struct {
+	ItemTs   string
+	SlackUid string
+	ItemUid  string
+	Reaction string
+	Category string
+	Weight   int64
+}
+
+const upsertReactionDelta = `
+INSERT INTO reaction_deltas (item_ts, slack_uid, item_uid, reaction, category, weight, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (item_ts, slack_uid, reaction) DO UPDATE SET
+	item_uid   = excluded.item_uid,
+	category   = excluded.category,
+	weight     = excluded.weight,
+	created_at = excluded.created_at
+`
+
+// UpsertReactionDeltaParams holds the arguments to UpsertReactionDelta.
+type UpsertReactionDeltaParams struct {
+	ItemTs    string
+	SlackUid  string
+	ItemUid   string
+	Reaction  string
+	Category  string
+	Weight    int64
+	CreatedAt string
+}
+
+// UpsertReactionDelta inserts or overwrites the delta for (ItemTs, SlackUid, Reaction).
+func (q *Queries) UpsertReactionDelta(ctx context.Context, arg UpsertReactionDeltaParams) error {
+	_, err := q.db.ExecContext(ctx, upsertReactionDelta, arg.ItemTs, arg.SlackUid, arg.ItemUid, arg.Reaction, arg.Category, arg.Weight, arg.CreatedAt)
+	return err
+}
+
+const topReactionReceiverBetween = `
+SELECT item_uid, SUM(weight) AS total
+FROM reaction_deltas
+WHERE category = ? AND created_at >= ? AND created_at < ?
+GROUP BY item_uid
+ORDER BY total DESC
+LIMIT 1
+`
+
+// TopReactionReceiver is the row type returned by TopReactionReceiverBetween.
+type TopReactionReceiver struct {
+	ItemUid string
+	Total   int64
+}
+
+// TopReactionReceiverBetweenParams holds the arguments to TopReactionReceiverBetween.
+type TopReactionReceiverBetweenParams struct {
+	Category  string
+	StartDate string
+	EndDate   string
+}
+
+// TopReactionReceiverBetween returns the item_uid with the highest summed
+// weight for Category within [StartDate, EndDate), and that sum. Returns
+// sql.ErrNoRows if no reaction_deltas fall in the range.
+func (q *Queries) TopReactionReceiverBetween(ctx context.Context, arg TopReactionReceiverBetweenParams) (TopReactionReceiver, error) {
+	row := q.db.QueryRowContext(ctx, topReactionReceiverBetween, arg.Category, arg.StartDate, arg.EndDate)
+	var r TopReactionReceiver
+	err := row.Scan(&r.ItemUid, &r.Total)
+	return r, err
+}
+
+const findReactionDelta = `
+SELECT item_ts, slack_uid, item_uid, reaction, category, weight
+FROM reaction_deltas
+WHERE item_ts = ? AND slack_uid = ? AND reaction = ?
+`
+
+// FindReactionDeltaParams holds the arguments to FindReactionDelta.
+type FindReactionDeltaParams struct {
+	ItemTs   string
+	SlackUid string
+	Reaction string
+}
+
+// FindReactionDelta returns the delta recorded for (ItemTs, SlackUid, Reaction).
+// Returns sql.ErrNoRows if no row matches.
+func (q *Queries) FindReactionDelta(ctx context.Context, arg FindReactionDeltaParams) (ReactionDelta, error) {
+	row := q.db.QueryRowContext(ctx, findReactionDelta, arg.ItemTs, arg.SlackUid, arg.Reaction)
+	var d ReactionDelta
+	err := row.Scan(&d.ItemTs, &d.SlackUid, &d.ItemUid, &d.Reaction, &d.Category, &d.Weight)
+	return d, err
+}
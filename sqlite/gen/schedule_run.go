@@ -0,0 +1,51 @@
+package gen
+
+import "context"
+
+// scheduleRunSchema creates the schedule_runs table backing
+// ScheduleRunService, recording the last time each named schedule entry
+// fired so a process restart doesn't cause a duplicate post within the same
+// minute. Applied by DB.Open alongside the rest of the schema.
+const scheduleRunSchema = `
+CREATE TABLE IF NOT EXISTS schedule_runs (
+	name     TEXT PRIMARY KEY,
+	last_run TEXT NOT NULL
+);
+`
+
+const upsertScheduleRun = `
+INSERT INTO schedule_runs (name, last_run)
+VALUES (?, ?)
+ON CONFLICT (name) DO UPDATE SET last_run = excluded.last_run
+`
+
+// UpsertScheduleRunParams holds the arguments to UpsertScheduleRun.
+type UpsertScheduleRunParams struct {
+	Name    string
+	LastRun string
+}
+
+// UpsertScheduleRun records that Name last fired at LastRun.
+func (q *Queries) UpsertScheduleRun(ctx context.Context, arg UpsertScheduleRunParams) error {
+	_, err := q.db.ExecContext(ctx, upsertScheduleRun, arg.Name, arg.LastRun)
+	return err
+}
+
+const findScheduleRun = `
+SELECT name, last_run FROM schedule_runs WHERE name = ?
+`
+
+// ScheduleRun is the row type returned by FindScheduleRun.
+type ScheduleRun struct {
+	Name    string
+	LastRun string
+}
+
+// FindScheduleRun returns the last recorded run for name.
+// Returns sql.ErrNoRows if name has never run.
+func (q *Queries) FindScheduleRun(ctx context.Context, name string) (ScheduleRun, error) {
+	row := q.db.QueryRowContext(ctx, findScheduleRun, name)
+	var r ScheduleRun
+	err := row.Scan(&r.Name, &r.LastRun)
+	return r, err
+}
@@ -3,6 +3,8 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/ddritzenhoff/stats"
@@ -11,25 +13,30 @@ import (
 
 // Ensure service implements interface.
 var _ stats.LeaderboardService = (*LeaderboardService)(nil)
+var _ stats.WeeklyLeaderboardService = (*LeaderboardService)(nil)
 
 // LeaderboardService represents a service for managing Members.
 type LeaderboardService struct {
-	query *gen.Queries
-	db    *sql.DB
+	db *DB
 }
 
-// NewLeaderboardService returns a new instance of MemberService.
-func NewLeaderboardService(query *gen.Queries, db *sql.DB) *LeaderboardService {
+// NewLeaderboardService returns a new instance of LeaderboardService.
+func NewLeaderboardService(db *DB) *LeaderboardService {
 	return &LeaderboardService{
-		query: query,
-		db:    db,
+		db: db,
 	}
 }
 
 // FindLeaderboard retrives a Leadboard by its date (year and month).
 // Returns ErrNotFound if no matches are found.
 func (ls *LeaderboardService) FindLeaderboard(date time.Time) (*stats.Leaderboard, error) {
-	genMostReceivedLikesMember, err := ls.query.MostLikesReceived(context.TODO(), date.Format(stats.MonthYearLayout))
+	tx, err := ls.db.BeginTx(context.TODO(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("FindLeaderboard: %w", err)
+	}
+	defer tx.Rollback()
+
+	genMostReceivedLikesMember, err := tx.Queries().MostLikesReceived(context.TODO(), date.Format(stats.MonthYearLayout))
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +45,7 @@ func (ls *LeaderboardService) FindLeaderboard(date time.Time) (*stats.Leaderboar
 		return nil, err
 	}
 
-	genMostReceivedDislikesMember, err := ls.query.MostDislikesReceived(context.TODO(), date.Format(stats.MonthYearLayout))
+	genMostReceivedDislikesMember, err := tx.Queries().MostDislikesReceived(context.TODO(), date.Format(stats.MonthYearLayout))
 	if err != nil {
 		return nil, err
 	}
@@ -47,9 +54,68 @@ func (ls *LeaderboardService) FindLeaderboard(date time.Time) (*stats.Leaderboar
 		return nil, err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return &stats.Leaderboard{
 		Date:                       date,
 		MostReceivedLikesMember:    *mostReceivedLikesMember,
 		MostReceivedDislikesMember: *mostReceivedDislikesMember,
 	}, nil
 }
+
+// FindWeeklyLeaderboard returns the Leaderboard for the 7 days ending on
+// weekEnding. Unlike FindLeaderboard, this can't read the totals straight off
+// a members row: Member stores one cumulative total per calendar month, with
+// no per-event log, so it can't answer "received in the last 7 days" and a
+// week straddling a month boundary would split across two rows anyway.
+// Instead it sums the per-event reaction_deltas table (see
+// ReactionHistoryService) over the window, which is exactly the per-event
+// time series this needs.
+func (ls *LeaderboardService) FindWeeklyLeaderboard(weekEnding time.Time) (*stats.Leaderboard, error) {
+	tx, err := ls.db.BeginTx(context.TODO(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("FindWeeklyLeaderboard: %w", err)
+	}
+	defer tx.Rollback()
+
+	weekStart := weekEnding.AddDate(0, 0, -7)
+	startDate := weekStart.UTC().Format(time.RFC3339)
+	endDate := weekEnding.UTC().Format(time.RFC3339)
+
+	topLikes, err := tx.Queries().TopReactionReceiverBetween(context.TODO(), gen.TopReactionReceiverBetweenParams{
+		Category:  keyLikes,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		topLikes = gen.TopReactionReceiver{}
+	} else if err != nil {
+		return nil, fmt.Errorf("FindWeeklyLeaderboard TopReactionReceiverBetween likes: %w", err)
+	}
+
+	topDislikes, err := tx.Queries().TopReactionReceiverBetween(context.TODO(), gen.TopReactionReceiverBetweenParams{
+		Category:  keyDislikes,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		topDislikes = gen.TopReactionReceiver{}
+	} else if err != nil {
+		return nil, fmt.Errorf("FindWeeklyLeaderboard TopReactionReceiverBetween dislikes: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	date := stats.NewMonthYear(weekEnding)
+	mostReceivedLikesMember := stats.NewMember(0, date, topLikes.ItemUid, topLikes.Total, 0, weekStart, weekEnding)
+	mostReceivedDislikesMember := stats.NewMember(0, date, topDislikes.ItemUid, 0, topDislikes.Total, weekStart, weekEnding)
+
+	return &stats.Leaderboard{
+		Date:                       weekEnding,
+		MostReceivedLikesMember:    *mostReceivedLikesMember,
+		MostReceivedDislikesMember: *mostReceivedDislikesMember,
+	}, nil
+}
@@ -35,14 +35,16 @@ func (ms *MemberService) FindMemberByID(id int64) (*stats.Member, error) {
 	}
 	defer tx.Rollback()
 
-	// fetch member
-	genMember, err := ms.db.query.FindMemberByID(context.TODO(), id)
+	genMember, err := tx.Queries().FindMemberByID(context.TODO(), id)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, stats.ErrNotFound
 	} else if err != nil {
 		return nil, err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return genMemberToMember(&genMember)
 }
 
@@ -55,35 +57,38 @@ func (ms *MemberService) FindMember(SlackUID string, date stats.MonthYear) (*sta
 	}
 	defer tx.Rollback()
 
-	genMember, err := ms.db.query.FindMember(context.TODO(), gen.FindMemberParams{
+	genMember, err := tx.Queries().FindMember(context.TODO(), gen.FindMemberParams{
 		SlackUid:  SlackUID,
 		MonthYear: date.String(),
 	})
-
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, stats.ErrNotFound
 	} else if err != nil {
 		return nil, err
 	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return genMemberToMember(&genMember)
 }
 
 // CreateMember creates a new Member.
 func (ms *MemberService) CreateMember(m *stats.Member) error {
+	if m == nil {
+		return fmt.Errorf("CreateMember: m reference is nil")
+	}
+
 	tx, err := ms.db.BeginTx(context.TODO(), nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	if m == nil {
-		return fmt.Errorf("CreateMember: m reference is nil")
-	}
-
-	m.CreatedAt = tx.now
+	m.CreatedAt = tx.Now()
 	m.UpdatedAt = m.CreatedAt
 
-	genMem, err := ms.db.query.CreateMember(context.TODO(), gen.CreateMemberParams{
+	genMem, err := tx.Queries().CreateMember(context.TODO(), gen.CreateMemberParams{
 		SlackUid:  m.SlackUID,
 		MonthYear: m.Date.String(),
 		CreatedAt: m.CreatedAt.UTC().Format(time.RFC3339),
@@ -104,11 +109,11 @@ func (ms *MemberService) CreateMember(m *stats.Member) error {
 func (ms *MemberService) UpdateMember(id int64, upd stats.MemberUpdate) error {
 	tx, err := ms.db.BeginTx(context.TODO(), nil)
 	if err != nil {
-		return fmt.Errorf("UpdateMember db.Begin: %w", err)
+		return fmt.Errorf("UpdateMember db.BeginTx: %w", err)
 	}
 	defer tx.Rollback()
 
-	genMember, err := ms.db.query.FindMemberByID(context.TODO(), id)
+	genMember, err := tx.Queries().FindMemberByID(context.TODO(), id)
 	if err != nil {
 		return fmt.Errorf("UpdateMember FindMemberByID: %w", err)
 	}
@@ -119,10 +124,10 @@ func (ms *MemberService) UpdateMember(id int64, upd stats.MemberUpdate) error {
 	if upd.ReceivedDislikes != nil {
 		genMember.ReceivedDislikes = *upd.ReceivedDislikes
 	}
-	err = ms.db.query.UpdateMember(context.TODO(), gen.UpdateMemberParams{
+	err = tx.Queries().UpdateMember(context.TODO(), gen.UpdateMemberParams{
 		ReceivedLikes:    genMember.ReceivedLikes,
 		ReceivedDislikes: genMember.ReceivedDislikes,
-		UpdatedAt:        time.Now().UTC().Format(time.RFC3339),
+		UpdatedAt:        tx.Now().UTC().Format(time.RFC3339),
 		ID:               id,
 	})
 	if err != nil {
@@ -132,6 +137,34 @@ func (ms *MemberService) UpdateMember(id int64, upd stats.MemberUpdate) error {
 	return tx.Commit()
 }
 
+// IncrementCounters adds likesDelta/dislikesDelta (either of which may be
+// negative) to the ReceivedLikes/ReceivedDislikes of the member row for
+// slackUID/date, creating it first if necessary. Unlike FindMember followed
+// by UpdateMember in separate transactions, this runs as a single
+// INSERT ... ON CONFLICT DO UPDATE statement, so two reactions arriving
+// concurrently for the same member can't race each other into a lost update.
+func (ms *MemberService) IncrementCounters(slackUID string, date stats.MonthYear, likesDelta int64, dislikesDelta int64) error {
+	tx, err := ms.db.BeginTx(context.TODO(), nil)
+	if err != nil {
+		return fmt.Errorf("IncrementCounters: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := tx.Now().UTC().Format(time.RFC3339)
+	if _, err := tx.Queries().IncrementMemberCounters(context.TODO(), gen.IncrementMemberCountersParams{
+		SlackUid:         slackUID,
+		MonthYear:        date.String(),
+		ReceivedLikes:    likesDelta,
+		ReceivedDislikes: dislikesDelta,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}); err != nil {
+		return fmt.Errorf("IncrementCounters IncrementMemberCounters: %w", err)
+	}
+
+	return tx.Commit()
+}
+
 // DeleteMember permanently deletes a Member.
 func (ms *MemberService) DeleteMember(id int64) error {
 	tx, err := ms.db.BeginTx(context.TODO(), nil)
@@ -140,11 +173,10 @@ func (ms *MemberService) DeleteMember(id int64) error {
 	}
 	defer tx.Rollback()
 
-	err = ms.db.query.DeleteMember(context.TODO(), id)
-	if err != nil {
+	if err := tx.Queries().DeleteMember(context.TODO(), id); err != nil {
 		return fmt.Errorf("DeleteMember: %w", err)
 	}
-	return nil
+	return tx.Commit()
 }
 
 // genMemberToMember converts the sqlite member type to the stats member type.
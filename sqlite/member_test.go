@@ -0,0 +1,46 @@
+package sqlite_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/ddritzenhoff/stats/sqlite"
+)
+
+// TestMemberService_IncrementCounters_Concurrent verifies that concurrent
+// IncrementCounters calls for the same member don't lose updates to each
+// other -- the exact race a single INSERT ... ON CONFLICT DO UPDATE
+// statement replaced a separate FindMember+UpdateMember pair to avoid.
+func TestMemberService_IncrementCounters_Concurrent(t *testing.T) {
+	db := sqlite.NewDB(":memory:")
+	if err := db.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	ms := sqlite.NewMemberService(db)
+	date := stats.NewMonthYear(time.Now().UTC())
+
+	const n = 50
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			if err := ms.IncrementCounters("U123", date, 1, 0); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	m, err := ms.FindMember("U123", date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.ReceivedLikes != n {
+		t.Fatalf("ReceivedLikes = %d, want %d", m.ReceivedLikes, n)
+	}
+}
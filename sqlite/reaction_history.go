@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/ddritzenhoff/stats/sqlite/gen"
+)
+
+// Ensure ReactionHistoryService implements interface.
+var _ stats.ReactionHistoryService = (*ReactionHistoryService)(nil)
+
+// ReactionHistoryService persists ReactionDeltas in the reaction_deltas
+// table, keyed by (item_ts, user, reaction).
+type ReactionHistoryService struct {
+	db *DB
+}
+
+// NewReactionHistoryService returns a new instance of ReactionHistoryService.
+func NewReactionHistoryService(db *DB) *ReactionHistoryService {
+	return &ReactionHistoryService{db: db}
+}
+
+// RecordDelta stores d, keyed by (d.ItemTS, d.User, d.Reaction).
+func (rh *ReactionHistoryService) RecordDelta(d stats.ReactionDelta) error {
+	tx, err := rh.db.BeginTx(context.TODO(), nil)
+	if err != nil {
+		return fmt.Errorf("RecordDelta: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := tx.Queries().UpsertReactionDelta(context.TODO(), gen.UpsertReactionDeltaParams{
+		ItemTs:    d.ItemTS,
+		SlackUid:  d.User,
+		ItemUid:   d.ItemUser,
+		Reaction:  d.Reaction,
+		Category:  d.Category,
+		Weight:    d.Weight,
+		CreatedAt: tx.Now().UTC().Format(time.RFC3339),
+	}); err != nil {
+		return fmt.Errorf("RecordDelta UpsertReactionDelta: %w", err)
+	}
+	return tx.Commit()
+}
+
+// FindDelta retrieves the ReactionDelta recorded for (itemTS, user, reaction).
+// Returns ErrNotFound if no matching record exists.
+func (rh *ReactionHistoryService) FindDelta(itemTS string, user string, reaction string) (*stats.ReactionDelta, error) {
+	tx, err := rh.db.BeginTx(context.TODO(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("FindDelta: %w", err)
+	}
+	defer tx.Rollback()
+
+	genDelta, err := tx.Queries().FindReactionDelta(context.TODO(), gen.FindReactionDeltaParams{
+		ItemTs:   itemTS,
+		SlackUid: user,
+		Reaction: reaction,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, stats.ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("FindDelta: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &stats.ReactionDelta{
+		ItemTS:   genDelta.ItemTs,
+		User:     genDelta.SlackUid,
+		ItemUser: genDelta.ItemUid,
+		Reaction: genDelta.Reaction,
+		Category: genDelta.Category,
+		Weight:   genDelta.Weight,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/ddritzenhoff/stats/sqlite/gen"
+)
+
+// Ensure ScheduleRunService implements interface.
+var _ stats.ScheduleRunService = (*ScheduleRunService)(nil)
+
+// ScheduleRunService persists the last time a named schedule entry fired in
+// the schedule_runs(name, last_run) table.
+type ScheduleRunService struct {
+	db *DB
+}
+
+// NewScheduleRunService returns a new instance of ScheduleRunService.
+func NewScheduleRunService(db *DB) *ScheduleRunService {
+	return &ScheduleRunService{db: db}
+}
+
+// LastRun returns the last time name fired, or the zero time if it has never run.
+func (ss *ScheduleRunService) LastRun(name string) (time.Time, error) {
+	tx, err := ss.db.BeginTx(context.TODO(), nil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("LastRun: %w", err)
+	}
+	defer tx.Rollback()
+
+	genRun, err := tx.Queries().FindScheduleRun(context.TODO(), name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, fmt.Errorf("LastRun: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return time.Time{}, err
+	}
+	lastRun, err := time.Parse(time.RFC3339, genRun.LastRun)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("LastRun: %w", err)
+	}
+	return lastRun, nil
+}
+
+// RecordRun records that name fired at t.
+func (ss *ScheduleRunService) RecordRun(name string, t time.Time) error {
+	tx, err := ss.db.BeginTx(context.TODO(), nil)
+	if err != nil {
+		return fmt.Errorf("RecordRun: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := tx.Queries().UpsertScheduleRun(context.TODO(), gen.UpsertScheduleRunParams{
+		Name:    name,
+		LastRun: t.UTC().Format(time.RFC3339),
+	}); err != nil {
+		return fmt.Errorf("RecordRun UpsertScheduleRun: %w", err)
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,112 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ddritzenhoff/stats"
+	"github.com/ddritzenhoff/stats/sqlite/gen"
+)
+
+// Ensure StatService implements interface.
+var _ stats.StatTx = (*StatService)(nil)
+
+// StatService implements stats.StatTx. The "likes" and "dislikes" keys are
+// routed to the legacy columns on the members table so that
+// LeaderboardService keeps working unmodified; every other key is stored in
+// the generic member_stats(slack_uid, month_year, key, value) side table, so new
+// StatProcessors never require a schema change.
+type StatService struct {
+	db *DB
+}
+
+// NewStatService returns a new instance of StatService.
+func NewStatService(db *DB) *StatService {
+	return &StatService{db: db}
+}
+
+const (
+	keyLikes    = "likes"
+	keyDislikes = "dislikes"
+)
+
+// Counter returns the current value of key for slackUID within date, or 0 if unset.
+func (ss *StatService) Counter(slackUID string, date stats.MonthYear, key string) (int64, error) {
+	switch key {
+	case keyLikes, keyDislikes:
+		genMember, err := ss.db.query.FindMember(context.TODO(), gen.FindMemberParams{
+			SlackUid:  slackUID,
+			MonthYear: date.String(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		} else if err != nil {
+			return 0, fmt.Errorf("Counter: %w", err)
+		}
+		if key == keyLikes {
+			return genMember.ReceivedLikes, nil
+		}
+		return genMember.ReceivedDislikes, nil
+	default:
+		value, err := ss.db.query.FindMemberStat(context.TODO(), gen.FindMemberStatParams{
+			SlackUid:  slackUID,
+			MonthYear: date.String(),
+			Key:       key,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		} else if err != nil {
+			return 0, fmt.Errorf("Counter: %w", err)
+		}
+		return value, nil
+	}
+}
+
+// SetCounter sets the value of key for slackUID within date.
+func (ss *StatService) SetCounter(slackUID string, date stats.MonthYear, key string, value int64) error {
+	current, err := ss.Counter(slackUID, date, key)
+	if err != nil {
+		return fmt.Errorf("SetCounter: %w", err)
+	}
+	return ss.IncrementCounter(slackUID, date, key, value-current)
+}
+
+// IncrementCounter adds delta (which may be negative) to the current value of
+// key for slackUID within date, creating the member and/or the side-table row
+// as necessary.
+func (ss *StatService) IncrementCounter(slackUID string, date stats.MonthYear, key string, delta int64) error {
+	switch key {
+	case keyLikes:
+		return ss.incrementMemberColumn(slackUID, date, delta, 0)
+	case keyDislikes:
+		return ss.incrementMemberColumn(slackUID, date, 0, delta)
+	default:
+		tx, err := ss.db.BeginTx(context.TODO(), nil)
+		if err != nil {
+			return fmt.Errorf("IncrementCounter: %w", err)
+		}
+		defer tx.Rollback()
+
+		if err := tx.Queries().UpsertMemberStat(context.TODO(), gen.UpsertMemberStatParams{
+			SlackUid:  slackUID,
+			MonthYear: date.String(),
+			Key:       key,
+			Value:     delta,
+		}); err != nil {
+			return fmt.Errorf("IncrementCounter UpsertMemberStat: %w", err)
+		}
+		return tx.Commit()
+	}
+}
+
+// incrementMemberColumn applies likesDelta/dislikesDelta to the legacy
+// counter columns of the member row for slackUID/date, creating it first if
+// necessary. Delegates to MemberService.IncrementCounters, which does this in
+// one transaction instead of a separate FindMember+UpdateMember pair, so two
+// reactions landing on the same member at once can't race into a lost update.
+func (ss *StatService) incrementMemberColumn(slackUID string, date stats.MonthYear, likesDelta int64, dislikesDelta int64) error {
+	ms := NewMemberService(ss.db)
+	return ms.IncrementCounters(slackUID, date, likesDelta, dislikesDelta)
+}
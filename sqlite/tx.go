@@ -0,0 +1,40 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ddritzenhoff/stats/sqlite/gen"
+)
+
+// Tx wraps a *sql.Tx together with the gen.Queries bound to it via WithTx, so
+// a sqlite service method that begins one with DB.BeginTx (defined alongside
+// DB) runs its reads and writes through that one transaction. now is frozen the moment the
+// transaction begins, so a method that stamps both CreatedAt and UpdatedAt
+// writes the same instant to both instead of two calls to time.Now()
+// drifting apart.
+type Tx struct {
+	*sql.Tx
+	query *gen.Queries
+	now   time.Time
+}
+
+// NewTx returns a new instance of Tx wrapping tx, with its gen.Queries bound
+// via WithTx and now frozen to the moment it's called.
+func NewTx(tx *sql.Tx, query *gen.Queries, now time.Time) *Tx {
+	return &Tx{
+		Tx:    tx,
+		query: query.WithTx(tx),
+		now:   now,
+	}
+}
+
+// Queries returns the gen.Queries bound to this transaction.
+func (tx *Tx) Queries() *gen.Queries {
+	return tx.query
+}
+
+// Now returns the time this transaction began.
+func (tx *Tx) Now() time.Time {
+	return tx.now
+}
@@ -0,0 +1,11 @@
+package stats
+
+import "time"
+
+// WeeklyLeaderboardService computes a Leaderboard scoped to the 7 days
+// ending on a given time, for the "weekly_top" schedule action, as opposed to
+// LeaderboardService's calendar-month scope.
+type WeeklyLeaderboardService interface {
+	// FindWeeklyLeaderboard returns the Leaderboard for the 7 days ending on weekEnding.
+	FindWeeklyLeaderboard(weekEnding time.Time) (*Leaderboard, error)
+}